@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &accessPermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &accessPermissionsDataSource{}
+)
+
+func NewAccessPermissionsDataSource() datasource.DataSource {
+	return &accessPermissionsDataSource{}
+}
+
+type accessPermissionsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type accessPermissionsDataSourceModel struct {
+	Data   *accessPermissionsDataSourceDataModel   `tfsdk:"data"`
+	Filter *accessPermissionsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type accessPermissionsDataSourceFilterModel struct {
+	Path types.String `tfsdk:"path"`
+}
+
+type accessPermissionsDataSourceDataModel struct {
+	Permissions map[string][]types.String `tfsdk:"permissions"`
+}
+
+func (d *accessPermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *accessPermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_access_permissions"
+}
+
+func (d *accessPermissionsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the effective permissions of the API token used by the provider.",
+		MarkdownDescription: "Retrieves the effective permissions of the API token used by the provider.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"permissions": schema.MapAttribute{
+						Description:         "Sorted list of privileges granted on each ACL path (eg: /vms/100)",
+						MarkdownDescription: "Sorted list of privileges granted on each ACL path (eg: `/vms/100`)",
+						Computed:            true,
+						ElementType:         types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						Description: "Only return the permissions on this ACL path (eg: /storage/local); all " +
+							"paths are returned when omitted",
+						MarkdownDescription: "Only return the permissions on this ACL path (eg: `/storage/local`); " +
+							"all paths are returned when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *accessPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config accessPermissionsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the permissions; the privileges map to whether or not they propagate which is not needed here
+	apiPath := "/access/permissions"
+	if config.Filter != nil && config.Filter.Path.ValueString() != "" {
+		apiPath += "?path=" + url.QueryEscape(config.Filter.Path.ValueString())
+	}
+	var permissions map[string]map[string]any
+	if err := d.providerData.client.Get(ctx, apiPath, &permissions); err != nil {
+		tflog.Error(ctx, "failed to retrieve access permissions", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Access Permissions",
+			fmt.Sprintf("Failed to retrieve the permissions of the API token:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := accessPermissionsDataSourceModel{
+		Data: &accessPermissionsDataSourceDataModel{
+			Permissions: map[string][]types.String{},
+		},
+		Filter: config.Filter,
+	}
+	for aclPath, privileges := range permissions {
+		values := []types.String{}
+		for _, privilege := range slices.Sorted(maps.Keys(privileges)) {
+			values = append(values, types.StringValue(privilege))
+		}
+		state.Data.Permissions[aclPath] = values
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
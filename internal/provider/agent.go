@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// agentHostname returns the hostname reported by the QEMU guest agent running inside the given VM.
+func (p *proxmoxveProviderData) agentHostname(ctx context.Context, nodeName string, vmID int) (string, error) {
+	var result struct {
+		Result struct {
+			HostName string `json:"host-name"`
+		} `json:"result"`
+	}
+	err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/agent/get-host-name",
+		url.PathEscape(nodeName), vmID), &result)
+	if err != nil {
+		return "", err
+	}
+	return result.Result.HostName, nil
+}
+
+// agentOSInfo returns the operating system information reported by the QEMU guest agent running inside the
+// given VM.
+func (p *proxmoxveProviderData) agentOSInfo(ctx context.Context, nodeName string, vmID int) (*proxmox.AgentOsInfo,
+	error) {
+
+	var result struct {
+		Result *proxmox.AgentOsInfo `json:"result"`
+	}
+	err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/agent/get-osinfo", url.PathEscape(nodeName), vmID), &result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Result == nil {
+		return nil, fmt.Errorf("the guest agent returned an empty result")
+	}
+	return result.Result, nil
+}
+
+// agentFilesystem is a single filesystem reported by the QEMU guest agent.
+type agentFilesystem struct {
+	Disks []struct {
+		BusType string `json:"bus-type"`
+		Dev     string `json:"dev"`
+		Serial  string `json:"serial"`
+		Target  int    `json:"target"`
+		Unit    int    `json:"unit"`
+	} `json:"disk"`
+	Mountpoint string `json:"mountpoint"`
+	Name       string `json:"name"`
+	TotalBytes *int64 `json:"total-bytes"`
+	Type       string `json:"type"`
+	UsedBytes  *int64 `json:"used-bytes"`
+}
+
+// agentFSInfo returns the filesystems reported by the QEMU guest agent running inside the given VM.
+func (p *proxmoxveProviderData) agentFSInfo(ctx context.Context, nodeName string, vmID int) ([]agentFilesystem,
+	error) {
+
+	var result struct {
+		Result []agentFilesystem `json:"result"`
+	}
+	err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/agent/get-fsinfo", url.PathEscape(nodeName), vmID), &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Result, nil
+}
+
+// agentNetworkInterfaces returns the network interfaces reported by the QEMU guest agent running inside the
+// given VM.
+func (p *proxmoxveProviderData) agentNetworkInterfaces(ctx context.Context, nodeName string, vmID int) (
+	[]*proxmox.AgentNetworkIface, error) {
+
+	var result struct {
+		Result []*proxmox.AgentNetworkIface `json:"result"`
+	}
+	err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/agent/network-get-interfaces",
+		url.PathEscape(nodeName), vmID), &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Result, nil
+}
+
+// agentEnabled returns whether or not the QEMU guest agent is enabled in the given raw VM configuration.
+func agentEnabled(rawConfig map[string]any) bool {
+	return parsePropertyString(configString(rawConfig, "agent").ValueString(), "enabled")["enabled"] == "1"
+}
+
+// agentDiskUsage sums up the space used by and the total size of the filesystems on the disk with the given
+// serial number. Filesystems are only matched by serial number: the bus, target and unit seen by the guest depend
+// on the SCSI controller type (eg: every disk sits at unit 0 of its own controller with virtio-scsi-single), so
+// they cannot be mapped back to the disk's interface. The returned bool is false if the disk has no serial number
+// or no filesystem could be matched.
+func agentDiskUsage(filesystems []agentFilesystem, serial string) (int64, int64, bool) {
+	if serial == "" {
+		return 0, 0, false
+	}
+	var used, total int64
+	matched := false
+	for _, fs := range filesystems {
+		if fs.UsedBytes == nil || fs.TotalBytes == nil {
+			continue
+		}
+		for _, disk := range fs.Disks {
+			if disk.Serial == serial {
+				used += *fs.UsedBytes
+				total += *fs.TotalBytes
+				matched = true
+				break
+			}
+		}
+	}
+	return used, total, matched
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAgentDiskUsage(t *testing.T) {
+	// two SCSI disks without a serial number, each at unit 0 of its own virtio-scsi-single controller, and a disk
+	// with a serial number holding two filesystems
+	var filesystems []agentFilesystem
+	err := json.Unmarshal([]byte(`[
+		{"name": "sda1", "mountpoint": "/", "used-bytes": 100, "total-bytes": 1000,
+			"disk": [{"bus-type": "scsi", "bus": 0, "target": 0, "unit": 0, "serial": "", "dev": "/dev/sda1"}]},
+		{"name": "sdb1", "mountpoint": "/srv", "used-bytes": 200, "total-bytes": 2000,
+			"disk": [{"bus-type": "scsi", "bus": 0, "target": 0, "unit": 0, "serial": "", "dev": "/dev/sdb1"}]},
+		{"name": "vda1", "mountpoint": "/var", "used-bytes": 30, "total-bytes": 300,
+			"disk": [{"bus-type": "virtio", "serial": "data-disk", "dev": "/dev/vda1"}]},
+		{"name": "vda2", "mountpoint": "/home", "used-bytes": 40, "total-bytes": 400,
+			"disk": [{"bus-type": "virtio", "serial": "data-disk", "dev": "/dev/vda2"}]},
+		{"name": "vdb1", "mountpoint": "/tmp",
+			"disk": [{"bus-type": "virtio", "serial": "scratch", "dev": "/dev/vdb1"}]}
+	]`), &filesystems)
+	if err != nil {
+		t.Fatalf("failed to decode the filesystems: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		serial      string
+		wantUsed    int64
+		wantTotal   int64
+		wantMatched bool
+	}{
+		{name: "no serial", wantMatched: false},
+		{name: "serial matching two filesystems", serial: "data-disk", wantUsed: 70, wantTotal: 700, wantMatched: true},
+		{name: "filesystem without usage", serial: "scratch", wantMatched: false},
+		{name: "unknown serial", serial: "missing", wantMatched: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			used, total, matched := agentDiskUsage(filesystems, test.serial)
+			if used != test.wantUsed || total != test.wantTotal || matched != test.wantMatched {
+				t.Errorf("agentDiskUsage(%q) = %d, %d, %t, want %d, %d, %t", test.serial, used, total, matched,
+					test.wantUsed, test.wantTotal, test.wantMatched)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &applianceTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &applianceTemplatesDataSource{}
+)
+
+func NewApplianceTemplatesDataSource() datasource.DataSource {
+	return &applianceTemplatesDataSource{}
+}
+
+type applianceTemplatesDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type applianceTemplatesDataSourceModel struct {
+	Data   []applianceTemplatesDataSourceTemplateModel `tfsdk:"data"`
+	Filter *applianceTemplatesDataSourceFilterModel    `tfsdk:"filter"`
+}
+
+type applianceTemplatesDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type applianceTemplatesDataSourceTemplateModel struct {
+	Description types.String `tfsdk:"description"`
+	Location    types.String `tfsdk:"location"`
+	OS          types.String `tfsdk:"os"`
+	Package     types.String `tfsdk:"package"`
+	Section     types.String `tfsdk:"section"`
+	SHA512Sum   types.String `tfsdk:"sha512sum"`
+	Template    types.String `tfsdk:"template"`
+	Version     types.String `tfsdk:"version"`
+}
+
+// applianceTemplate is a single entry from the node's appliance template list.
+type applianceTemplate struct {
+	Description string `json:"description"`
+	Location    string `json:"location"`
+	OS          string `json:"os"`
+	Package     string `json:"package"`
+	Section     string `json:"section"`
+	SHA512Sum   string `json:"sha512sum"`
+	Template    string `json:"template"`
+	Version     string `json:"version"`
+}
+
+func (d *applianceTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *applianceTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_appliance_templates"
+}
+
+func (d *applianceTemplatesDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the container appliance templates available for download on a node.",
+		MarkdownDescription: "Retrieves the container appliance templates available for download on a node.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"location": schema.StringAttribute{
+							Description:         "URL the template is downloaded from",
+							MarkdownDescription: "URL the template is downloaded from",
+							Computed:            true,
+						},
+						"os": schema.StringAttribute{
+							Computed: true,
+						},
+						"package": schema.StringAttribute{
+							Computed: true,
+						},
+						"section": schema.StringAttribute{
+							Description:         "Template category (eg: system, turnkeylinux)",
+							MarkdownDescription: "Template category (eg: `system`, `turnkeylinux`)",
+							Computed:            true,
+						},
+						"sha512sum": schema.StringAttribute{
+							Computed: true,
+						},
+						"template": schema.StringAttribute{
+							Description:         "File name of the template",
+							MarkdownDescription: "File name of the template",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node to list templates for; defaults to the provider's " +
+							"default_node when omitted",
+						MarkdownDescription: "Name of the node to list templates for; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *applianceTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config applianceTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &applianceTemplatesDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the appliance "+
+				"templates or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the templates
+	var templates []applianceTemplate
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/aplinfo", url.PathEscape(nodeName)), &templates)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve appliance templates", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Appliance Templates",
+			fmt.Sprintf("Failed to retrieve the appliance templates for the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].OS != templates[j].OS {
+			return templates[i].OS < templates[j].OS
+		}
+		if templates[i].Version != templates[j].Version {
+			return templates[i].Version < templates[j].Version
+		}
+		return templates[i].Template < templates[j].Template
+	})
+
+	// map the response to the model
+	state := applianceTemplatesDataSourceModel{
+		Data:   []applianceTemplatesDataSourceTemplateModel{},
+		Filter: config.Filter,
+	}
+	for _, template := range templates {
+		state.Data = append(state.Data, applianceTemplatesDataSourceTemplateModel{
+			Description: types.StringValue(template.Description),
+			Location:    types.StringValue(template.Location),
+			OS:          types.StringValue(template.OS),
+			Package:     types.StringValue(template.Package),
+			Section:     types.StringValue(template.Section),
+			SHA512Sum:   types.StringValue(template.SHA512Sum),
+			Template:    types.StringValue(template.Template),
+			Version:     types.StringValue(template.Version),
+		})
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	backupJobStateError    = "error"
+	backupJobStateNeverRun = "never_run"
+	backupJobStateOK       = "ok"
+	backupJobStateRunning  = "running"
+
+	// backupJobLogLimit is the maximum number of lines of a backup task log which are read to find the job it
+	// belongs to and count the guests which were backed up.
+	backupJobLogLimit = 5000
+)
+
+var (
+	// backupJobGuestOKPattern matches the task log line written when the backup of a guest succeeded.
+	backupJobGuestOKPattern = regexp.MustCompile(`^INFO: Finished Backup of VM (\d+)`)
+
+	// backupJobGuestFailedPattern matches the task log line written when the backup of a guest failed.
+	backupJobGuestFailedPattern = regexp.MustCompile(`^ERROR: Backup of VM (\d+) failed`)
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &backupJobStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &backupJobStatusDataSource{}
+)
+
+func NewBackupJobStatusDataSource() datasource.DataSource {
+	return &backupJobStatusDataSource{}
+}
+
+type backupJobStatusDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type backupJobStatusDataSourceModel struct {
+	Data   *backupJobStatusDataSourceDataModel   `tfsdk:"data"`
+	Filter *backupJobStatusDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type backupJobStatusDataSourceFilterModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+type backupJobStatusDataSourceDataModel struct {
+	EndTime   types.String `tfsdk:"endtime"`
+	Error     types.String `tfsdk:"error"`
+	Failed    types.Int64  `tfsdk:"failed"`
+	Node      types.String `tfsdk:"node"`
+	OK        types.Int64  `tfsdk:"ok"`
+	StartTime types.String `tfsdk:"starttime"`
+	State     types.String `tfsdk:"state"`
+	Total     types.Int64  `tfsdk:"total"`
+	UPID      types.String `tfsdk:"upid"`
+}
+
+// clusterTask is a single entry from the list of recent tasks of the cluster.
+type clusterTask struct {
+	EndTime   int64  `json:"endtime"`
+	Node      string `json:"node"`
+	StartTime int64  `json:"starttime"`
+	Status    string `json:"status"`
+	Type      string `json:"type"`
+	UPID      string `json:"upid"`
+}
+
+func (d *backupJobStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *backupJobStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_backup_job_status"
+}
+
+func (d *backupJobStatusDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Result of the last run of a scheduled backup job. The run is found among the recent tasks of " +
+			"the cluster by the job ID which vzdump logs when it starts (Proxmox VE 8.1 or later); a job whose " +
+			"run is no longer in the task list is reported as never_run.",
+		MarkdownDescription: "Result of the last run of a scheduled backup job. The run is found among the recent " +
+			"tasks of the cluster by the job ID which vzdump logs when it starts (Proxmox VE 8.1 or later); a job " +
+			"whose run is no longer in the task list is reported as `never_run`.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"endtime": schema.StringAttribute{
+						Description: "Time the last run ended, formatted using the provider's time_format; null " +
+							"when the job is running or has never run",
+						MarkdownDescription: "Time the last run ended, formatted using the provider's " +
+							"`time_format`; null when the job is running or has never run",
+						Computed: true,
+					},
+					"error": schema.StringAttribute{
+						Description:         "Exit status of the last run when it did not succeed; null otherwise",
+						MarkdownDescription: "Exit status of the last run when it did not succeed; null otherwise",
+						Computed:            true,
+					},
+					"failed": schema.Int64Attribute{
+						Description:         "Number of guests whose backup failed during the last run",
+						MarkdownDescription: "Number of guests whose backup failed during the last run",
+						Computed:            true,
+					},
+					"node": schema.StringAttribute{
+						Description:         "Node the last run was executed on; null when the job has never run",
+						MarkdownDescription: "Node the last run was executed on; null when the job has never run",
+						Computed:            true,
+					},
+					"ok": schema.Int64Attribute{
+						Description:         "Number of guests which were backed up during the last run",
+						MarkdownDescription: "Number of guests which were backed up during the last run",
+						Computed:            true,
+					},
+					"starttime": schema.StringAttribute{
+						Description: "Time the last run started, formatted using the provider's time_format; null " +
+							"when the job has never run",
+						MarkdownDescription: "Time the last run started, formatted using the provider's " +
+							"`time_format`; null when the job has never run",
+						Computed: true,
+					},
+					"state": schema.StringAttribute{
+						Description:         "State of the last run (ok, error, running or never_run)",
+						MarkdownDescription: "State of the last run (`ok`, `error`, `running` or `never_run`)",
+						Computed:            true,
+					},
+					"total": schema.Int64Attribute{
+						Description:         "Number of guests processed during the last run",
+						MarkdownDescription: "Number of guests processed during the last run",
+						Computed:            true,
+					},
+					"upid": schema.StringAttribute{
+						Description: "ID of the task of the last run, which can be used to retrieve its log; null " +
+							"when the job has never run",
+						MarkdownDescription: "ID of the task of the last run, which can be used to retrieve its log; " +
+							"null when the job has never run",
+						Computed: true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "ID of the backup job (eg: backup-1a2b3c4d-5e6f)",
+						MarkdownDescription: "ID of the backup job (eg: `backup-1a2b3c4d-5e6f`)",
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *backupJobStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config backupJobStatusDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a job ID is specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the backup job status.",
+		)
+		return
+	}
+	jobID := strings.TrimSpace(config.Filter.ID.ValueString())
+	if jobID == "" {
+		resp.Diagnostics.AddError(
+			"Filter ID Is Required", "You must specify the ID of the backup job to retrieve its status.",
+		)
+		return
+	}
+
+	// make sure the job exists
+	var job map[string]any
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/cluster/backup/%s", url.PathEscape(jobID)), &job)
+	if isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Backup Job Not Found", fmt.Sprintf("The backup job '%s' does not exist.", jobID),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve backup job", map[string]any{"id": jobID, "error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Backup Job",
+			fmt.Sprintf("Failed to retrieve the backup job '%s':\n\t%s", jobID, err.Error()),
+		)
+		return
+	}
+
+	// query for the recent backup tasks, newest first
+	var tasks []clusterTask
+	if err := d.providerData.client.Get(ctx, "/cluster/tasks", &tasks); err != nil {
+		tflog.Error(ctx, "failed to retrieve cluster tasks", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Tasks",
+			fmt.Sprintf("Failed to retrieve the tasks of the cluster:\n\t%s", err.Error()),
+		)
+		return
+	}
+	tasks = slices.DeleteFunc(tasks, func(task clusterTask) bool {
+		return task.Type != "vzdump"
+	})
+	slices.SortFunc(tasks, func(a, b clusterTask) int {
+		return cmp.Compare(b.StartTime, a.StartTime)
+	})
+
+	// find the last run of the job from the task logs
+	state := backupJobStatusDataSourceModel{
+		Data: &backupJobStatusDataSourceDataModel{
+			EndTime:   types.StringNull(),
+			Error:     types.StringNull(),
+			Failed:    types.Int64Value(0),
+			Node:      types.StringNull(),
+			OK:        types.Int64Value(0),
+			StartTime: types.StringNull(),
+			State:     types.StringValue(backupJobStateNeverRun),
+			Total:     types.Int64Value(0),
+			UPID:      types.StringNull(),
+		},
+		Filter: config.Filter,
+	}
+	for _, task := range tasks {
+		var lines []logLine
+		err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/tasks/%s/log?start=0&limit=%d",
+			url.PathEscape(task.Node), url.PathEscape(task.UPID), backupJobLogLimit), &lines)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve Task Log",
+				fmt.Sprintf("Failed to retrieve the log of the task '%s':\n\t%s", task.UPID, err.Error()),
+			)
+			return
+		}
+		ok, failed, found := summarizeBackupJobLog(lines, jobID)
+		if !found {
+			continue
+		}
+		tflog.Debug(ctx, "found last run of backup job", map[string]any{"id": jobID, "upid": task.UPID})
+
+		// map the task to the model
+		state.Data.Failed = types.Int64Value(failed)
+		state.Data.Node = types.StringValue(task.Node)
+		state.Data.OK = types.Int64Value(ok)
+		state.Data.StartTime = d.providerData.formatTimestamp(task.StartTime)
+		state.Data.Total = types.Int64Value(ok + failed)
+		state.Data.UPID = types.StringValue(task.UPID)
+		switch {
+		case task.Status == "":
+			state.Data.State = types.StringValue(backupJobStateRunning)
+		case task.Status == "OK":
+			state.Data.State = types.StringValue(backupJobStateOK)
+		default:
+			state.Data.State = types.StringValue(backupJobStateError)
+			state.Data.Error = types.StringValue(task.Status)
+		}
+		if task.Status != "" && task.EndTime > 0 {
+			state.Data.EndTime = d.providerData.formatTimestamp(task.EndTime)
+		}
+		break
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// summarizeBackupJobLog counts the guests which were and were not backed up according to the given vzdump task
+// log. found is false when the task was not started by the backup job with the given ID.
+func summarizeBackupJobLog(lines []logLine, jobID string) (ok, failed int64, found bool) {
+	for _, line := range lines {
+		switch {
+		case strings.Contains(line.T, "starting new backup job:"):
+			found = strings.Contains(line.T+" ", " --job-id "+jobID+" ")
+		case backupJobGuestOKPattern.MatchString(line.T):
+			ok++
+		case backupJobGuestFailedPattern.MatchString(line.T):
+			failed++
+		}
+	}
+	return ok, failed, found
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// diskCacheModes are the values accepted by the cache property of a disk.
+var diskCacheModes = []string{"directsync", "none", "unsafe", "writeback", "writethrough"}
+
+// diskFormats are the values accepted by the format property of a disk.
+var diskFormats = []string{"qcow2", "raw", "vmdk"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &buildDiskConfigFunction{}
+)
+
+func NewBuildDiskConfigFunction() function.Function {
+	return &buildDiskConfigFunction{}
+}
+
+type buildDiskConfigFunction struct{}
+
+// diskConfigOptions are the options of a disk which can be built into a disk configuration string.
+type diskConfigOptions struct {
+	Cache    types.String `tfsdk:"cache"`
+	Discard  types.Bool   `tfsdk:"discard"`
+	Format   types.String `tfsdk:"format"`
+	IOThread types.Bool   `tfsdk:"iothread"`
+	Size     types.Int64  `tfsdk:"size"`
+	SSD      types.Bool   `tfsdk:"ssd"`
+	Storage  types.String `tfsdk:"storage"`
+}
+
+func (f *buildDiskConfigFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "build_disk_config"
+}
+
+func (f *buildDiskConfigFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Builds a disk configuration string",
+		Description: "Builds the canonical disk configuration value for allocating a new disk (eg: " +
+			"local-lvm:32,discard=on,ssd=1) from the given options. The storage and the size (in GiB) are " +
+			"required; every other option must be present in the object but is omitted from the result when " +
+			"null. Options are written in alphabetical order: cache, discard, format, iothread and ssd.",
+		MarkdownDescription: "Builds the canonical disk configuration value for allocating a new disk (eg: " +
+			"`local-lvm:32,discard=on,ssd=1`) from the given options. The `storage` and the `size` (in GiB) are " +
+			"required; every other option must be present in the object but is omitted from the result when " +
+			"`null`. Options are written in alphabetical order: `cache`, `discard`, `format`, `iothread` and " +
+			"`ssd`.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name: "options",
+				Description: fmt.Sprintf("Disk options: storage, size (GiB), ssd, discard, cache (%s), iothread "+
+					"and format (%s)", strings.Join(diskCacheModes, ", "), strings.Join(diskFormats, ", ")),
+				AttributeTypes: map[string]attr.Type{
+					"cache":    types.StringType,
+					"discard":  types.BoolType,
+					"format":   types.StringType,
+					"iothread": types.BoolType,
+					"size":     types.Int64Type,
+					"ssd":      types.BoolType,
+					"storage":  types.StringType,
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildDiskConfigFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var options diskConfigOptions
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	config, err := buildDiskConfig(options)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, config))
+}
+
+// buildDiskConfig validates the given options and returns the disk configuration string for them. The volume is
+// written first, followed by the options which are set in alphabetical order, matching the order Proxmox VE uses
+// when it writes property strings.
+func buildDiskConfig(options diskConfigOptions) (string, error) {
+	storage := strings.TrimSpace(options.Storage.ValueString())
+	if storage == "" || strings.ContainsAny(storage, ":,= ") {
+		return "", fmt.Errorf("the storage must be a storage ID (eg: local-lvm): '%s'", storage)
+	}
+	if options.Size.IsNull() || options.Size.ValueInt64() <= 0 {
+		return "", fmt.Errorf("the size must be a positive number of GiB")
+	}
+
+	pairs := []string{fmt.Sprintf("%s:%d", storage, options.Size.ValueInt64())}
+	if !options.Cache.IsNull() {
+		if !slices.Contains(diskCacheModes, options.Cache.ValueString()) {
+			return "", fmt.Errorf("the cache '%s' is not valid; it must be one of %s", options.Cache.ValueString(),
+				strings.Join(diskCacheModes, ", "))
+		}
+		pairs = append(pairs, "cache="+options.Cache.ValueString())
+	}
+	if !options.Discard.IsNull() {
+		discard := "ignore"
+		if options.Discard.ValueBool() {
+			discard = "on"
+		}
+		pairs = append(pairs, "discard="+discard)
+	}
+	if !options.Format.IsNull() {
+		if !slices.Contains(diskFormats, options.Format.ValueString()) {
+			return "", fmt.Errorf("the format '%s' is not valid; it must be one of %s",
+				options.Format.ValueString(), strings.Join(diskFormats, ", "))
+		}
+		pairs = append(pairs, "format="+options.Format.ValueString())
+	}
+	if !options.IOThread.IsNull() {
+		pairs = append(pairs, "iothread="+strconv.Itoa(boolToInt(options.IOThread.ValueBool())))
+	}
+	if !options.SSD.IsNull() {
+		pairs = append(pairs, "ssd="+strconv.Itoa(boolToInt(options.SSD.ValueBool())))
+	}
+	return strings.Join(pairs, ","), nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nullDiskConfigOptions returns disk options for the given storage and size with every other option null.
+func nullDiskConfigOptions(storage string, size int64) diskConfigOptions {
+	return diskConfigOptions{
+		Cache:    types.StringNull(),
+		Discard:  types.BoolNull(),
+		Format:   types.StringNull(),
+		IOThread: types.BoolNull(),
+		Size:     types.Int64Value(size),
+		SSD:      types.BoolNull(),
+		Storage:  types.StringValue(storage),
+	}
+}
+
+func TestBuildDiskConfig(t *testing.T) {
+	full := diskConfigOptions{
+		Cache:    types.StringValue("writeback"),
+		Discard:  types.BoolValue(true),
+		Format:   types.StringValue("raw"),
+		IOThread: types.BoolValue(false),
+		Size:     types.Int64Value(32),
+		SSD:      types.BoolValue(true),
+		Storage:  types.StringValue("local-lvm"),
+	}
+	discardIgnored := nullDiskConfigOptions("ceph", 100)
+	discardIgnored.Discard = types.BoolValue(false)
+	badCache := nullDiskConfigOptions("local-lvm", 32)
+	badCache.Cache = types.StringValue("fast")
+	badFormat := nullDiskConfigOptions("local-lvm", 32)
+	badFormat.Format = types.StringValue("vdi")
+
+	tests := []struct {
+		name    string
+		options diskConfigOptions
+		want    string
+		wantErr string
+	}{
+		{name: "minimal", options: nullDiskConfigOptions("local-lvm", 32), want: "local-lvm:32"},
+		{
+			name:    "fully populated",
+			options: full,
+			want:    "local-lvm:32,cache=writeback,discard=on,format=raw,iothread=0,ssd=1",
+		},
+		{name: "discard ignored", options: discardIgnored, want: "ceph:100,discard=ignore"},
+		{name: "padded storage", options: nullDiskConfigOptions(" local ", 8), want: "local:8"},
+		{name: "missing storage", options: nullDiskConfigOptions("", 32), wantErr: "the storage must be"},
+		{name: "volume as storage", options: nullDiskConfigOptions("local:32", 32), wantErr: "the storage must be"},
+		{name: "zero size", options: nullDiskConfigOptions("local-lvm", 0), wantErr: "the size must be"},
+		{name: "invalid cache", options: badCache, wantErr: "the cache 'fast' is not valid"},
+		{name: "invalid format", options: badFormat, wantErr: "the format 'vdi' is not valid"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := buildDiskConfig(test.options)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("buildDiskConfig() error = %v, want an error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildDiskConfig() unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("buildDiskConfig() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBuildDiskConfigFunctionRun(t *testing.T) {
+	options := types.ObjectValueMust(
+		map[string]attr.Type{
+			"cache":    types.StringType,
+			"discard":  types.BoolType,
+			"format":   types.StringType,
+			"iothread": types.BoolType,
+			"size":     types.Int64Type,
+			"ssd":      types.BoolType,
+			"storage":  types.StringType,
+		},
+		map[string]attr.Value{
+			"cache":    types.StringNull(),
+			"discard":  types.BoolValue(true),
+			"format":   types.StringNull(),
+			"iothread": types.BoolNull(),
+			"size":     types.Int64Value(32),
+			"ssd":      types.BoolValue(true),
+			"storage":  types.StringValue("local-lvm"),
+		},
+	)
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{options})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	(&buildDiskConfigFunction{}).Run(context.Background(), req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("Run() unexpected error: %v", resp.Error)
+	}
+	if want := types.StringValue("local-lvm:32,discard=on,ssd=1"); !resp.Result.Value().Equal(want) {
+		t.Errorf("Run() = %v, want %v", resp.Result.Value(), want)
+	}
+}
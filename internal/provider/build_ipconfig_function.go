@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &buildIPConfigFunction{}
+)
+
+func NewBuildIPConfigFunction() function.Function {
+	return &buildIPConfigFunction{}
+}
+
+type buildIPConfigFunction struct{}
+
+// ipConfigOptions are the addresses and gateways which can be built into a cloud-init ipconfig string.
+type ipConfigOptions struct {
+	Gateway  types.String `tfsdk:"gateway"`
+	Gateway6 types.String `tfsdk:"gateway6"`
+	IP       types.String `tfsdk:"ip"`
+	IP6      types.String `tfsdk:"ip6"`
+}
+
+func (f *buildIPConfigFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "build_ipconfig"
+}
+
+func (f *buildIPConfigFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Builds a cloud-init ipconfig string",
+		Description: "Builds the canonical cloud-init ipconfig value (eg: ip=10.0.0.5/24,gw=10.0.0.1,ip6=auto) " +
+			"from an object with the ip, gateway, ip6 and gateway6 attributes. Every attribute must be present " +
+			"in the object but null or empty ones are omitted. A gateway may only be given together with a " +
+			"static address of the same family and must be within its network, except for link-local IPv6 " +
+			"gateways. At least one address is required.",
+		MarkdownDescription: "Builds the canonical cloud-init `ipconfig` value (eg: " +
+			"`ip=10.0.0.5/24,gw=10.0.0.1,ip6=auto`) from an object with the `ip`, `gateway`, `ip6` and " +
+			"`gateway6` attributes. Every attribute must be present in the object but `null` or empty ones are " +
+			"omitted. A gateway may only be given together with a static address of the same family and must " +
+			"be within its network, except for link-local IPv6 gateways. At least one address is required.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name: "options",
+				Description: "Addresses and gateways: ip (IPv4 CIDR or dhcp), gateway, ip6 (IPv6 CIDR, dhcp or " +
+					"auto) and gateway6",
+				AttributeTypes: map[string]attr.Type{
+					"gateway":  types.StringType,
+					"gateway6": types.StringType,
+					"ip":       types.StringType,
+					"ip6":      types.StringType,
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildIPConfigFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var options ipConfigOptions
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	config, err := buildIPConfig(options)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, config))
+}
+
+// buildIPConfig validates the given addresses and gateways and returns the ipconfig string for them, with the
+// IPv4 pairs before the IPv6 ones.
+func buildIPConfig(options ipConfigOptions) (string, error) {
+	ipv4Pairs, err := buildIPConfigPairs(options.IP.ValueString(), options.Gateway.ValueString(), false)
+	if err != nil {
+		return "", err
+	}
+	ipv6Pairs, err := buildIPConfigPairs(options.IP6.ValueString(), options.Gateway6.ValueString(), true)
+	if err != nil {
+		return "", err
+	}
+	pairs := append(ipv4Pairs, ipv6Pairs...)
+	if len(pairs) == 0 {
+		return "", fmt.Errorf("at least one of ip or ip6 must be given")
+	}
+	return strings.Join(pairs, ","), nil
+}
+
+// buildIPConfigPairs validates the address and gateway of one address family and returns the ipconfig key=value
+// pairs for them.
+func buildIPConfigPairs(address, gateway string, ipv6 bool) ([]string, error) {
+	addressKey, gatewayKey, addressAttr, gatewayAttr := "ip", "gw", "ip", "gateway"
+	family, dynamic := "IPv4", []string{"dhcp"}
+	if ipv6 {
+		addressKey, gatewayKey, addressAttr, gatewayAttr = "ip6", "gw6", "ip6", "gateway6"
+		family, dynamic = "IPv6", []string{"dhcp", "auto"}
+	}
+	addressValue := strings.TrimSpace(address)
+	gatewayValue := strings.TrimSpace(gateway)
+	if addressValue == "" {
+		if gatewayValue != "" {
+			return nil, fmt.Errorf("%s requires a static %s address in %s", gatewayAttr, family, addressAttr)
+		}
+		return nil, nil
+	}
+
+	// dynamic addresses never have a gateway
+	for _, mode := range dynamic {
+		if strings.EqualFold(addressValue, mode) {
+			if gatewayValue != "" {
+				return nil, fmt.Errorf("%s cannot be used with '%s'; it requires a static %s address in %s",
+					gatewayAttr, mode, family, addressAttr)
+			}
+			return []string{addressKey + "=" + mode}, nil
+		}
+	}
+
+	if !isCIDR(addressValue, ipv6) {
+		return nil, fmt.Errorf("%s '%s' must be %s or an %s address in CIDR notation", addressAttr, addressValue,
+			strings.Join(dynamic, " or "), family)
+	}
+	info, prefix, err := parseCIDR(addressValue)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s", addressAttr, err.Error())
+	}
+	pairs := []string{addressKey + "=" + info.CIDR}
+	if gatewayValue != "" {
+		if !isIP(gatewayValue, ipv6) {
+			return nil, fmt.Errorf("%s '%s' is not a valid %s address", gatewayAttr, gatewayValue, family)
+		}
+		gatewayAddr := netip.MustParseAddr(gatewayValue)
+		if gatewayAddr == prefix.Addr() {
+			return nil, fmt.Errorf("%s '%s' cannot be the same as the address", gatewayAttr, gatewayValue)
+		}
+		// IPv6 routers are commonly reached through their link-local address, which is never within the prefix
+		if !prefix.Contains(gatewayAddr) && !(ipv6 && gatewayAddr.IsLinkLocalUnicast()) {
+			return nil, fmt.Errorf("%s '%s' is not within the network %s of the address", gatewayAttr,
+				gatewayValue, info.Network)
+		}
+		pairs = append(pairs, gatewayKey+"="+gatewayAddr.String())
+	}
+	return pairs, nil
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildIPConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		options ipConfigOptions
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "dhcp and auto",
+			options: ipConfigOptions{IP: types.StringValue("dhcp"), IP6: types.StringValue("auto")},
+			want:    "ip=dhcp,ip6=auto",
+		},
+		{
+			name: "static dual stack",
+			options: ipConfigOptions{
+				IP:       types.StringValue("10.0.0.5/24"),
+				Gateway:  types.StringValue("10.0.0.1"),
+				IP6:      types.StringValue("2001:db8::5/64"),
+				Gateway6: types.StringValue("2001:db8::1"),
+			},
+			want: "ip=10.0.0.5/24,gw=10.0.0.1,ip6=2001:db8::5/64,gw6=2001:db8::1",
+		},
+		{
+			name:    "IPv6 only with a link-local gateway",
+			options: ipConfigOptions{IP6: types.StringValue("2001:db8::5/64"), Gateway6: types.StringValue("fe80::1")},
+			want:    "ip6=2001:db8::5/64,gw6=fe80::1",
+		},
+		{
+			name:    "empty values omitted",
+			options: ipConfigOptions{IP: types.StringValue(" 10.0.0.5/24 "), Gateway: types.StringValue("")},
+			want:    "ip=10.0.0.5/24",
+		},
+		{
+			name:    "no address",
+			options: ipConfigOptions{},
+			wantErr: "at least one of ip or ip6 must be given",
+		},
+		{
+			name:    "gateway outside the network",
+			options: ipConfigOptions{IP: types.StringValue("10.0.0.5/24"), Gateway: types.StringValue("10.0.1.1")},
+			wantErr: "not within the network 10.0.0.0/24",
+		},
+		{
+			name: "IPv6 gateway outside the network",
+			options: ipConfigOptions{
+				IP6:      types.StringValue("2001:db8::5/64"),
+				Gateway6: types.StringValue("2001:db9::1"),
+			},
+			wantErr: "not within the network 2001:db8::/64",
+		},
+		{
+			name:    "gateway equal to the address",
+			options: ipConfigOptions{IP: types.StringValue("10.0.0.5/24"), Gateway: types.StringValue("10.0.0.5")},
+			wantErr: "cannot be the same as the address",
+		},
+		{
+			name:    "gateway with dhcp",
+			options: ipConfigOptions{IP: types.StringValue("dhcp"), Gateway: types.StringValue("10.0.0.1")},
+			wantErr: "gateway cannot be used with 'dhcp'",
+		},
+		{
+			name:    "gateway without an address",
+			options: ipConfigOptions{IP6: types.StringValue("auto"), Gateway: types.StringValue("10.0.0.1")},
+			wantErr: "gateway requires a static IPv4 address",
+		},
+		{
+			name:    "gateway of the wrong family",
+			options: ipConfigOptions{IP: types.StringValue("10.0.0.5/24"), Gateway: types.StringValue("2001:db8::1")},
+			wantErr: "is not a valid IPv4 address",
+		},
+		{
+			name:    "address without a prefix length",
+			options: ipConfigOptions{IP: types.StringValue("10.0.0.5")},
+			wantErr: "must be dhcp or an IPv4 address in CIDR notation",
+		},
+		{
+			name:    "auto is IPv6 only",
+			options: ipConfigOptions{IP: types.StringValue("auto")},
+			wantErr: "must be dhcp or an IPv4 address in CIDR notation",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := buildIPConfig(test.options)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("buildIPConfig() error = %v, want an error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildIPConfig() unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("buildIPConfig() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBuildIPConfigFunctionRun(t *testing.T) {
+	options := types.ObjectValueMust(
+		map[string]attr.Type{
+			"gateway":  types.StringType,
+			"gateway6": types.StringType,
+			"ip":       types.StringType,
+			"ip6":      types.StringType,
+		},
+		map[string]attr.Value{
+			"gateway":  types.StringValue("10.0.0.1"),
+			"gateway6": types.StringNull(),
+			"ip":       types.StringValue("10.0.0.5/24"),
+			"ip6":      types.StringValue("auto"),
+		},
+	)
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{options})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	(&buildIPConfigFunction{}).Run(context.Background(), req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("Run() unexpected error: %v", resp.Error)
+	}
+	if want := types.StringValue("ip=10.0.0.5/24,gw=10.0.0.1,ip6=auto"); !resp.Result.Value().Equal(want) {
+		t.Errorf("Run() = %v, want %v", resp.Result.Value(), want)
+	}
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bwlimitSchemaAttribute returns the schema of the optional bwlimit attribute shared by resources which move
+// data around the cluster, such as migrations, backups and restores. The operation is used in the description
+// (eg: "migration").
+func bwlimitSchemaAttribute(operation string) schema.Int64Attribute {
+	description := fmt.Sprintf("Bandwidth limit for the %s in KiB/s; 0 means unlimited and the datacenter or "+
+		"storage default applies when omitted", operation)
+	return schema.Int64Attribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+	}
+}
+
+// validateBWLimit adds an error for the bwlimit attribute at the given path if the limit is negative.
+func validateBWLimit(limit types.Int64, attrPath path.Path, diags *diag.Diagnostics) {
+	if limit.IsNull() || limit.IsUnknown() || limit.ValueInt64() >= 0 {
+		return
+	}
+	diags.AddAttributeError(
+		attrPath,
+		"Invalid Bandwidth Limit",
+		fmt.Sprintf("The bandwidth limit must be 0 (unlimited) or a positive number of KiB/s: %d.",
+			limit.ValueInt64()),
+	)
+}
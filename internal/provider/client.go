@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// clusterVMResources returns the cluster resources of type 'vm' (QEMU VMs and LXC containers) using a single
+// API call.
+func (p *proxmoxveProviderData) clusterVMResources(ctx context.Context) (proxmox.ClusterResources, error) {
+	var resources proxmox.ClusterResources
+	if err := p.client.Get(ctx, "/cluster/resources?type=vm", &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// qemuVMNode returns the name of the node hosting the QEMU VM with the given ID, or an empty string if no such
+// VM exists in the cluster.
+func (p *proxmoxveProviderData) qemuVMNode(ctx context.Context, vmID int) (string, error) {
+	resources, err := p.clusterVMResources(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, res := range resources {
+		if res.Type == guestTypeQEMU && int(res.VMID) == vmID {
+			return res.Node, nil
+		}
+	}
+	return "", nil
+}
+
+// virtualMachine locates the given node and retrieves the VM with the given ID from it, adding an error to the
+// diagnostics and returning nil if either could not be retrieved.
+func (p *proxmoxveProviderData) virtualMachine(ctx context.Context, nodeName string, vmID int,
+	diags *diag.Diagnostics) *proxmox.VirtualMachine {
+
+	return p.getVirtualMachine(ctx, nodeName, vmID, false, diags)
+}
+
+// virtualMachineIfExists behaves like virtualMachine except that it returns nil without adding an error to the
+// diagnostics when the node exists but the VM does not.
+func (p *proxmoxveProviderData) virtualMachineIfExists(ctx context.Context, nodeName string, vmID int,
+	diags *diag.Diagnostics) *proxmox.VirtualMachine {
+
+	return p.getVirtualMachine(ctx, nodeName, vmID, true, diags)
+}
+
+func (p *proxmoxveProviderData) getVirtualMachine(ctx context.Context, nodeName string, vmID int,
+	allowMissing bool, diags *diag.Diagnostics) *proxmox.VirtualMachine {
+
+	node, err := p.client.Node(ctx, nodeName)
+	if err != nil {
+		tflog.Error(ctx, "failed to locate cluster node", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		diags.AddError(
+			"Proxmox VE API: Failed to Locate Node",
+			fmt.Sprintf("Failed to locate the cluster node '%s':\n\t%s", nodeName, err.Error()),
+		)
+		return nil
+	}
+	vm, err := node.VirtualMachine(ctx, vmID)
+	if err != nil {
+		if allowMissing && isNotFoundError(err) {
+			tflog.Info(ctx, "VM does not exist", map[string]any{"node_name": nodeName, "vm_id": vmID})
+			return nil
+		}
+		diags.AddError(
+			"Proxmox VE API: Failed to Retrieve VM",
+			fmt.Sprintf("Failed to retrieve the virtual machine with the ID '%d':\n\t%s", vmID, err.Error()),
+		)
+		return nil
+	}
+	return vm
+}
+
+// notFoundMessages match the exact messages the API reports when the requested object does not exist:
+//   - Configuration file 'nodes/pve1/qemu-server/100.conf' does not exist (VMs and containers)
+//   - job 'backup-1', domain 'ldap', storage 'nfs', server 'influx' or pool 'dev' does not exist or not found
+//     (entries of the cluster-wide configuration files)
+//   - no such user ('jdoe@pve')
+var notFoundMessages = []*regexp.Regexp{
+	regexp.MustCompile(`^Configuration file '[^']+' does not exist$`),
+	regexp.MustCompile(`^(job|domain|storage|server|pool) '[^']+' (does not exist|not found)$`),
+	regexp.MustCompile(`^no such user \('[^']+'\)$`),
+}
+
+// isNotFoundError returns whether or not the given API error indicates that the requested object does not
+// exist. The API reports most missing objects as an internal server error, which the client returns as the
+// status line (eg: '500 storage 'nfs' does not exist'), so only those with a known message are matched to keep
+// unrelated errors from removing a resource from the state.
+func isNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if proxmox.IsNotFound(err) {
+		return true
+	}
+	status, message, _ := strings.Cut(err.Error(), " ")
+	switch status {
+	case "404":
+		return true
+	case "500":
+		for _, pattern := range notFoundMessages {
+			if pattern.MatchString(strings.TrimSpace(message)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rawVMConfig retrieves the configuration of the given VM as a map of the raw values returned by the API. This
+// exposes options that are not part of the client library's typed configuration and allows unset options to be
+// told apart from options explicitly set to their zero value.
+func (p *proxmoxveProviderData) rawVMConfig(ctx context.Context, nodeName string, vmID int) (map[string]any,
+	error) {
+
+	var config map[string]any
+	if err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/config",
+		url.PathEscape(nodeName), vmID), &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// rawContainerConfig retrieves the configuration of the given LXC container as a map of the raw values returned
+// by the API.
+func (p *proxmoxveProviderData) rawContainerConfig(ctx context.Context, nodeName string, vmID int) (
+	map[string]any, error) {
+
+	var config map[string]any
+	if err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/lxc/%d/config",
+		url.PathEscape(nodeName), vmID), &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// vmPendingConfigEntry is a single entry from a VM's pending configuration.
+type vmPendingConfigEntry struct {
+	Delete  proxmox.IntOrBool `json:"delete"`
+	Key     string            `json:"key"`
+	Pending any               `json:"pending"`
+}
+
+// vmHasPendingChanges returns whether or not the given VM has configuration changes which have not been applied
+// yet, meaning a reboot is required for the active configuration to match the pending one.
+func (p *proxmoxveProviderData) vmHasPendingChanges(ctx context.Context, nodeName string, vmID int) (bool,
+	error) {
+
+	var entries []vmPendingConfigEntry
+	if err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/pending",
+		url.PathEscape(nodeName), vmID), &entries); err != nil {
+		return false, err
+	}
+	for _, entry := range entries {
+		if entry.Pending != nil || bool(entry.Delete) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// nodeBridge is a Linux or Open vSwitch bridge configured on a node.
+type nodeBridge struct {
+	BridgeVLANAware proxmox.IntOrBool `json:"bridge_vlan_aware"`
+	Iface           string            `json:"iface"`
+	Type            string            `json:"type"`
+}
+
+// vlanAware returns whether or not the bridge passes VLAN tags through. Open vSwitch bridges always do.
+func (b nodeBridge) vlanAware() bool {
+	return b.Type == "OVSBridge" || bool(b.BridgeVLANAware)
+}
+
+// nodeBridges returns the bridges configured on the given node keyed by their interface name.
+func (p *proxmoxveProviderData) nodeBridges(ctx context.Context, nodeName string) (map[string]nodeBridge, error) {
+	var bridges []nodeBridge
+	err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/network?type=any_bridge", url.PathEscape(nodeName)), &bridges)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]nodeBridge{}
+	for _, bridge := range bridges {
+		result[bridge.Iface] = bridge
+	}
+	return result, nil
+}
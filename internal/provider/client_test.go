@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: nil, want: false},
+		{err: proxmox.ErrNotFound, want: true},
+		{err: fmt.Errorf("lookup: %w", proxmox.ErrNotFound), want: true},
+		{err: errors.New("404 Not Found"), want: true},
+		{err: errors.New("500 Configuration file 'nodes/pve1/qemu-server/100.conf' does not exist"), want: true},
+		{err: errors.New("500 Configuration file 'nodes/pve1/lxc/101.conf' does not exist"), want: true},
+		{err: errors.New("500 storage 'nfs' does not exist"), want: true},
+		{err: errors.New("500 domain 'ldap' does not exist"), want: true},
+		{err: errors.New("500 job 'backup-1a2b' does not exist"), want: true},
+		{err: errors.New("500 server 'influx' not found"), want: true},
+		{err: errors.New("500 no such user ('jdoe@pve')"), want: true},
+		{err: errors.New("500 unable to open file - file not found"), want: false},
+		{err: errors.New("500 command 'qm' failed: volume does not exist on target"), want: false},
+		{err: errors.New("500 Internal Server Error"), want: false},
+		{err: errors.New("400 Parameter verification failed: not found"), want: false},
+		{err: errors.New("dial tcp: lookup pve1: no such host"), want: false},
+		{err: errors.New("context deadline exceeded"), want: false},
+	}
+	for _, test := range tests {
+		name := "nil"
+		if test.err != nil {
+			name = test.err.Error()
+		}
+		t.Run(name, func(t *testing.T) {
+			if got := isNotFoundError(test.err); got != test.want {
+				t.Errorf("isNotFoundError(%v) = %t, want %t", test.err, got, test.want)
+			}
+		})
+	}
+}
+
+// newTestProviderData returns provider data whose client talks to a test server running the given handler.
+func newTestProviderData(t *testing.T, handler http.HandlerFunc) *proxmoxveProviderData {
+	t.Helper()
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	client := proxmox.NewClient(server.URL+"/api2/json", proxmox.WithHTTPClient(server.Client()))
+	return &proxmoxveProviderData{client: client}
+}
+
+// writeTestData writes the given value as the data of a Proxmox VE API response.
+func writeTestData(t *testing.T, w http.ResponseWriter, data any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]any{"data": data}); err != nil {
+		t.Errorf("failed to write response: %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterJoinInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterJoinInfoDataSource{}
+)
+
+func NewClusterJoinInfoDataSource() datasource.DataSource {
+	return &clusterJoinInfoDataSource{}
+}
+
+type clusterJoinInfoDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type clusterJoinInfoDataSourceModel struct {
+	Data   *clusterJoinInfoDataSourceDataModel   `tfsdk:"data"`
+	Filter *clusterJoinInfoDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type clusterJoinInfoDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type clusterJoinInfoDataSourceDataModel struct {
+	ClusterName   types.String                         `tfsdk:"cluster_name"`
+	ConfigDigest  types.String                         `tfsdk:"config_digest"`
+	Nodes         []clusterJoinInfoDataSourceNodeModel `tfsdk:"nodes"`
+	PreferredNode types.String                         `tfsdk:"preferred_node"`
+}
+
+type clusterJoinInfoDataSourceNodeModel struct {
+	Fingerprint  types.String `tfsdk:"fingerprint"`
+	Name         types.String `tfsdk:"name"`
+	NodeID       types.Int64  `tfsdk:"node_id"`
+	PVEAddress   types.String `tfsdk:"pve_address"`
+	QuorumVotes  types.Int64  `tfsdk:"quorum_votes"`
+	Ring0Address types.String `tfsdk:"ring0_address"`
+}
+
+// clusterJoinInfo is the subset of the response from the cluster join information endpoint.
+type clusterJoinInfo struct {
+	ConfigDigest string `json:"config_digest"`
+	NodeList     []struct {
+		Name         string                  `json:"name"`
+		NodeID       proxmox.StringOrFloat64 `json:"nodeid"`
+		PVEAddress   string                  `json:"pve_addr"`
+		PVEFP        string                  `json:"pve_fp"`
+		QuorumVotes  proxmox.StringOrFloat64 `json:"quorum_votes"`
+		Ring0Address string                  `json:"ring0_addr"`
+	} `json:"nodelist"`
+	PreferredNode string `json:"preferred_node"`
+	Totem         struct {
+		ClusterName string `json:"cluster_name"`
+	} `json:"totem"`
+}
+
+func (d *clusterJoinInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *clusterJoinInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_cluster_join_info"
+}
+
+func (d *clusterJoinInfoDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the information needed to join a node to the cluster, including the expected " +
+			"certificate fingerprint of each node. Requires the Sys.Audit permission on /.",
+		MarkdownDescription: "Retrieves the information needed to join a node to the cluster, including the " +
+			"expected certificate fingerprint of each node. Requires the `Sys.Audit` permission on `/`.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"cluster_name": schema.StringAttribute{
+						Computed: true,
+					},
+					"config_digest": schema.StringAttribute{
+						Computed: true,
+					},
+					"nodes": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"fingerprint": schema.StringAttribute{
+									Description:         "SHA-256 fingerprint of the node's API certificate",
+									MarkdownDescription: "SHA-256 fingerprint of the node's API certificate",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"node_id": schema.Int64Attribute{
+									Computed: true,
+								},
+								"pve_address": schema.StringAttribute{
+									Computed: true,
+								},
+								"quorum_votes": schema.Int64Attribute{
+									Computed: true,
+								},
+								"ring0_address": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"preferred_node": schema.StringAttribute{
+						Description:         "Node whose information should be used when joining",
+						MarkdownDescription: "Node whose information should be used when joining",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Node to retrieve the join information from; defaults to the node " +
+							"answering the request",
+						MarkdownDescription: "Node to retrieve the join information from; defaults to the node " +
+							"answering the request",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterJoinInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config clusterJoinInfoDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the join information
+	endpoint := "/cluster/config/join"
+	if config.Filter != nil && config.Filter.NodeName.ValueString() != "" {
+		endpoint += "?node=" + url.QueryEscape(config.Filter.NodeName.ValueString())
+	}
+	var info clusterJoinInfo
+	if err := d.providerData.client.Get(ctx, endpoint, &info); err != nil {
+		tflog.Error(ctx, "failed to retrieve cluster join information", map[string]any{"error": err.Error()})
+		if proxmox.IsNotAuthorized(err) {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Permission Denied",
+				"The API token or user is not permitted to read the cluster join information. The Sys.Audit "+
+					"permission on / is required.",
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Join Information",
+			fmt.Sprintf("Failed to retrieve the cluster join information:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := clusterJoinInfoDataSourceModel{
+		Data: &clusterJoinInfoDataSourceDataModel{
+			ClusterName:   types.StringValue(info.Totem.ClusterName),
+			ConfigDigest:  types.StringValue(info.ConfigDigest),
+			Nodes:         []clusterJoinInfoDataSourceNodeModel{},
+			PreferredNode: types.StringValue(info.PreferredNode),
+		},
+		Filter: config.Filter,
+	}
+	for _, node := range info.NodeList {
+		state.Data.Nodes = append(state.Data.Nodes, clusterJoinInfoDataSourceNodeModel{
+			Fingerprint:  types.StringValue(node.PVEFP),
+			Name:         types.StringValue(node.Name),
+			NodeID:       types.Int64Value(int64(node.NodeID)),
+			PVEAddress:   types.StringValue(node.PVEAddress),
+			QuorumVotes:  types.Int64Value(int64(node.QuorumVotes)),
+			Ring0Address: types.StringValue(node.Ring0Address),
+		})
+	}
+	sort.Slice(state.Data.Nodes, func(i, j int) bool {
+		return state.Data.Nodes[i].Name.ValueString() < state.Data.Nodes[j].Name.ValueString()
+	})
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterLogDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterLogDataSource{}
+)
+
+func NewClusterLogDataSource() datasource.DataSource {
+	return &clusterLogDataSource{}
+}
+
+type clusterLogDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type clusterLogDataSourceModel struct {
+	Data   []clusterLogDataSourceEntryModel `tfsdk:"data"`
+	Filter *clusterLogDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type clusterLogDataSourceFilterModel struct {
+	Max types.Int64 `tfsdk:"max"`
+}
+
+type clusterLogDataSourceEntryModel struct {
+	Msg           types.String `tfsdk:"msg"`
+	Node          types.String `tfsdk:"node"`
+	Pri           types.Int64  `tfsdk:"pri"`
+	Tag           types.String `tfsdk:"tag"`
+	Time          types.Int64  `tfsdk:"time"`
+	TimeFormatted types.String `tfsdk:"time_formatted"`
+	User          types.String `tfsdk:"user"`
+}
+
+// clusterLogEntry is a single entry of the cluster log as returned by the API.
+type clusterLogEntry struct {
+	Msg  string `json:"msg"`
+	Node string `json:"node"`
+	Pri  int64  `json:"pri"`
+	Tag  string `json:"tag"`
+	Time int64  `json:"time"`
+	UID  string `json:"uid"`
+	User string `json:"user"`
+}
+
+func (d *clusterLogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *clusterLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_cluster_log"
+}
+
+func (d *clusterLogDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the most recent entries of the cluster log, newest first.",
+		MarkdownDescription: "Retrieves the most recent entries of the cluster log, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"msg": schema.StringAttribute{
+							Computed: true,
+						},
+						"node": schema.StringAttribute{
+							Description:         "Node which logged the entry",
+							MarkdownDescription: "Node which logged the entry",
+							Computed:            true,
+						},
+						"pri": schema.Int64Attribute{
+							Description:         "Syslog priority of the entry (eg: 6 for informational)",
+							MarkdownDescription: "Syslog priority of the entry (eg: `6` for informational)",
+							Computed:            true,
+						},
+						"tag": schema.StringAttribute{
+							Description:         "Service which logged the entry (eg: pvedaemon)",
+							MarkdownDescription: "Service which logged the entry (eg: `pvedaemon`)",
+							Computed:            true,
+						},
+						"time": schema.Int64Attribute{
+							Description:         "Time of the entry as a Unix timestamp",
+							MarkdownDescription: "Time of the entry as a Unix timestamp",
+							Computed:            true,
+						},
+						"time_formatted": schema.StringAttribute{
+							Description:         "Time of the entry in the provider's time_format",
+							MarkdownDescription: "Time of the entry in the provider's `time_format`",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							Description:         "User which triggered the entry (eg: root@pam)",
+							MarkdownDescription: "User which triggered the entry (eg: `root@pam`)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"max": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of entries to return (default: %d, maximum: %d)",
+							defaultLogLineLimit, maxLogLineLimit),
+						MarkdownDescription: fmt.Sprintf(
+							"Maximum number of entries to return (default: `%d`, maximum: `%d`)",
+							defaultLogLineLimit, maxLogLineLimit),
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterLogDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config clusterLogDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure the limit is valid
+	maxEntries := types.Int64Null()
+	if config.Filter != nil {
+		maxEntries = config.Filter.Max
+	}
+	limit, err := logLineLimit(maxEntries)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Filter Max", fmt.Sprintf("The filter max is invalid: %s", err.Error()),
+		)
+		return
+	}
+
+	// query for the log entries
+	var entries []clusterLogEntry
+	if err := d.providerData.client.Get(ctx, fmt.Sprintf("/cluster/log?max=%d", limit), &entries); err != nil {
+		tflog.Error(ctx, "failed to retrieve cluster log", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Log",
+			fmt.Sprintf("Failed to retrieve the cluster log:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	slices.SortStableFunc(entries, func(a, b clusterLogEntry) int {
+		return cmp.Or(cmp.Compare(b.Time, a.Time), cmp.Compare(b.UID, a.UID))
+	})
+	state := clusterLogDataSourceModel{
+		Data:   []clusterLogDataSourceEntryModel{},
+		Filter: config.Filter,
+	}
+	for _, entry := range entries {
+		state.Data = append(state.Data, clusterLogDataSourceEntryModel{
+			Msg:           types.StringValue(entry.Msg),
+			Node:          types.StringValue(entry.Node),
+			Pri:           types.Int64Value(entry.Pri),
+			Tag:           types.StringValue(entry.Tag),
+			Time:          types.Int64Value(entry.Time),
+			TimeFormatted: d.providerData.formatTimestamp(entry.Time),
+			User:          types.StringValue(entry.User),
+		})
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
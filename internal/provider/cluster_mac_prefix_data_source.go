@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterMACPrefixDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterMACPrefixDataSource{}
+)
+
+func NewClusterMACPrefixDataSource() datasource.DataSource {
+	return &clusterMACPrefixDataSource{}
+}
+
+type clusterMACPrefixDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type clusterMACPrefixDataSourceModel struct {
+	Data *clusterMACPrefixDataSourceDataModel `tfsdk:"data"`
+}
+
+type clusterMACPrefixDataSourceDataModel struct {
+	MACPrefix types.String `tfsdk:"mac_prefix"`
+}
+
+func (d *clusterMACPrefixDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *clusterMACPrefixDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_cluster_mac_prefix"
+}
+
+func (d *clusterMACPrefixDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Prefix of the MAC addresses Proxmox VE generates for new network interfaces, as configured " +
+			"in the datacenter options.",
+		MarkdownDescription: "Prefix of the MAC addresses Proxmox VE generates for new network interfaces, as " +
+			"configured in the datacenter options.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"mac_prefix": schema.StringAttribute{
+						Description: "Configured MAC address prefix in upper case (eg: BC:24:11); null when no " +
+							"prefix is configured",
+						MarkdownDescription: "Configured MAC address prefix in upper case (eg: `BC:24:11`); null " +
+							"when no prefix is configured",
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterMACPrefixDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// query for the datacenter options
+	var options map[string]any
+	if err := d.providerData.client.Get(ctx, "/cluster/options", &options); err != nil {
+		tflog.Error(ctx, "failed to retrieve cluster options", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Options",
+			fmt.Sprintf("Failed to retrieve the datacenter options:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := clusterMACPrefixDataSourceModel{
+		Data: &clusterMACPrefixDataSourceDataModel{
+			MACPrefix: types.StringNull(),
+		},
+	}
+	if prefix := strings.TrimSpace(configString(options, "mac_prefix").ValueString()); prefix != "" {
+		state.Data.MACPrefix = types.StringValue(strings.ToUpper(prefix))
+	}
+
+	// set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	clusterMasterSourceHAManager    = "ha_manager"
+	clusterMasterSourceLowestNodeID = "lowest_node_id"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterMasterDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterMasterDataSource{}
+)
+
+func NewClusterMasterDataSource() datasource.DataSource {
+	return &clusterMasterDataSource{}
+}
+
+type clusterMasterDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type clusterMasterDataSourceModel struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	IP          types.String `tfsdk:"ip"`
+	NodeName    types.String `tfsdk:"node_name"`
+	Quorate     types.Bool   `tfsdk:"quorate"`
+	Source      types.String `tfsdk:"source"`
+}
+
+// clusterStatusEntry is a single entry of the cluster status, which describes either the cluster itself or one
+// of its nodes depending on its type.
+type clusterStatusEntry struct {
+	IP      string `json:"ip"`
+	Name    string `json:"name"`
+	NodeID  int    `json:"nodeid"`
+	Online  int    `json:"online"`
+	Quorate int    `json:"quorate"`
+	Type    string `json:"type"`
+}
+
+func (d *clusterMasterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *clusterMasterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_cluster_master"
+}
+
+func (d *clusterMasterDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Node currently acting as the cluster master. The cluster filesystem has no single master, so " +
+			"this is the node running the active HA manager or, when HA is not configured, the online node with " +
+			"the lowest node ID. Returns an error when the node is not part of a cluster.",
+		MarkdownDescription: "Node currently acting as the cluster master. The cluster filesystem has no single " +
+			"master, so this is the node running the active HA manager or, when HA is not configured, the online " +
+			"node with the lowest node ID. Returns an error when the node is not part of a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"ip": schema.StringAttribute{
+				Description:         "IP address the master node uses for cluster communication",
+				MarkdownDescription: "IP address the master node uses for cluster communication",
+				Computed:            true,
+			},
+			"node_name": schema.StringAttribute{
+				Description:         "Name of the master node",
+				MarkdownDescription: "Name of the master node",
+				Computed:            true,
+			},
+			"quorate": schema.BoolAttribute{
+				Description:         "Whether or not the cluster has quorum",
+				MarkdownDescription: "Whether or not the cluster has quorum",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				Description: "How the master node was determined (ha_manager when it runs the active HA manager, " +
+					"otherwise lowest_node_id)",
+				MarkdownDescription: "How the master node was determined (`ha_manager` when it runs the active HA " +
+					"manager, otherwise `lowest_node_id`)",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *clusterMasterDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// query for the cluster status
+	var entries []clusterStatusEntry
+	if err := d.providerData.client.Get(ctx, "/cluster/status", &entries); err != nil {
+		tflog.Error(ctx, "failed to retrieve cluster status", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Status",
+			fmt.Sprintf("Failed to retrieve the cluster status:\n\t%s", err.Error()),
+		)
+		return
+	}
+	var cluster *clusterStatusEntry
+	nodes := map[string]clusterStatusEntry{}
+	for i, entry := range entries {
+		switch entry.Type {
+		case "cluster":
+			cluster = &entries[i]
+		case "node":
+			nodes[entry.Name] = entry
+		}
+	}
+	if cluster == nil {
+		resp.Diagnostics.AddError(
+			"Cluster Not Configured",
+			"The node is not part of a cluster, so there is no cluster master. Use the node itself for "+
+				"cluster-wide operations on a standalone installation.",
+		)
+		return
+	}
+
+	// the active HA manager is the master if HA is configured
+	var haEntries []haStatusEntry
+	if err := d.providerData.client.Get(ctx, "/cluster/ha/status/current", &haEntries); err != nil {
+		tflog.Error(ctx, "failed to retrieve HA status", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve HA Status",
+			fmt.Sprintf("Failed to retrieve the HA status:\n\t%s", err.Error()),
+		)
+		return
+	}
+	state := clusterMasterDataSourceModel{
+		ClusterName: types.StringValue(cluster.Name),
+		IP:          types.StringNull(),
+		NodeName:    types.StringNull(),
+		Quorate:     types.BoolValue(cluster.Quorate != 0),
+		Source:      types.StringNull(),
+	}
+	var master *clusterStatusEntry
+	for _, entry := range haEntries {
+		if node, ok := nodes[entry.Node]; ok && entry.Type == haStatusTypeMaster {
+			master = &node
+			state.Source = types.StringValue(clusterMasterSourceHAManager)
+		}
+	}
+	if master == nil {
+		for _, node := range nodes {
+			if node.Online != 0 && (master == nil || node.NodeID < master.NodeID) {
+				master = &node
+			}
+		}
+		state.Source = types.StringValue(clusterMasterSourceLowestNodeID)
+	}
+	if master == nil {
+		resp.Diagnostics.AddError(
+			"Cluster Master Not Found",
+			fmt.Sprintf("None of the nodes of the cluster '%s' is online.", cluster.Name),
+		)
+		return
+	}
+
+	// map the response to the model
+	state.NodeName = types.StringValue(master.Name)
+	if master.IP != "" {
+		state.IP = types.StringValue(master.IP)
+	}
+
+	// set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterOptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterOptionsDataSource{}
+)
+
+func NewClusterOptionsDataSource() datasource.DataSource {
+	return &clusterOptionsDataSource{}
+}
+
+type clusterOptionsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type clusterOptionsDataSourceModel struct {
+	Data *clusterOptionsDataSourceDataModel `tfsdk:"data"`
+}
+
+type clusterOptionsDataSourceDataModel struct {
+	BandwidthLimits  *clusterOptionsDataSourceBandwidthLimitsModel `tfsdk:"bwlimit"`
+	Console          types.String                                  `tfsdk:"console"`
+	Description      types.String                                  `tfsdk:"description"`
+	EmailFrom        types.String                                  `tfsdk:"email_from"`
+	HAShutdownPolicy types.String                                  `tfsdk:"ha_shutdown_policy"`
+	Keyboard         types.String                                  `tfsdk:"keyboard"`
+	Language         types.String                                  `tfsdk:"language"`
+	MACPrefix        types.String                                  `tfsdk:"mac_prefix"`
+	MaxWorkers       types.Int64                                   `tfsdk:"max_workers"`
+	Migration        *clusterOptionsDataSourceMigrationModel       `tfsdk:"migration"`
+}
+
+type clusterOptionsDataSourceBandwidthLimitsModel struct {
+	Clone     types.Int64 `tfsdk:"clone"`
+	Default   types.Int64 `tfsdk:"default"`
+	Migration types.Int64 `tfsdk:"migration"`
+	Move      types.Int64 `tfsdk:"move"`
+	Restore   types.Int64 `tfsdk:"restore"`
+}
+
+type clusterOptionsDataSourceMigrationModel struct {
+	Network types.String `tfsdk:"network"`
+	Type    types.String `tfsdk:"type"`
+}
+
+func (d *clusterOptionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *clusterOptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_cluster_options"
+}
+
+func (d *clusterOptionsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"bwlimit": schema.SingleNestedAttribute{
+						Description:         "Default I/O bandwidth limits per operation in KiB/s",
+						MarkdownDescription: "Default I/O bandwidth limits per operation in KiB/s",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"clone": schema.Int64Attribute{
+								Computed: true,
+							},
+							"default": schema.Int64Attribute{
+								Computed: true,
+							},
+							"migration": schema.Int64Attribute{
+								Computed: true,
+							},
+							"move": schema.Int64Attribute{
+								Computed: true,
+							},
+							"restore": schema.Int64Attribute{
+								Computed: true,
+							},
+						},
+					},
+					"console": schema.StringAttribute{
+						Computed: true,
+					},
+					"description": schema.StringAttribute{
+						Computed: true,
+					},
+					"email_from": schema.StringAttribute{
+						Computed: true,
+					},
+					"ha_shutdown_policy": schema.StringAttribute{
+						Computed: true,
+					},
+					"keyboard": schema.StringAttribute{
+						Computed: true,
+					},
+					"language": schema.StringAttribute{
+						Computed: true,
+					},
+					"mac_prefix": schema.StringAttribute{
+						Computed: true,
+					},
+					"max_workers": schema.Int64Attribute{
+						Computed: true,
+					},
+					"migration": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"network": schema.StringAttribute{
+								Computed: true,
+							},
+							"type": schema.StringAttribute{
+								Description:         "Migration transport, either 'secure' or 'insecure'",
+								MarkdownDescription: "Migration transport, either `secure` or `insecure`",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// query for the datacenter options
+	var options map[string]any
+	if err := d.providerData.client.Get(ctx, "/cluster/options", &options); err != nil {
+		tflog.Error(ctx, "failed to retrieve cluster options", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Options",
+			fmt.Sprintf("Failed to retrieve the datacenter options:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	bwlimit := parsePropertyString(configString(options, "bwlimit").ValueString(), "")
+	ha := parsePropertyString(configString(options, "ha").ValueString(), "")
+	migration := parsePropertyString(configString(options, "migration").ValueString(), "type")
+	state := clusterOptionsDataSourceModel{
+		Data: &clusterOptionsDataSourceDataModel{
+			BandwidthLimits: &clusterOptionsDataSourceBandwidthLimitsModel{
+				Clone:     propertyInt64(bwlimit, "clone"),
+				Default:   propertyInt64(bwlimit, "default"),
+				Migration: propertyInt64(bwlimit, "migration"),
+				Move:      propertyInt64(bwlimit, "move"),
+				Restore:   propertyInt64(bwlimit, "restore"),
+			},
+			Console:          configString(options, "console"),
+			Description:      configString(options, "description"),
+			EmailFrom:        configString(options, "email_from"),
+			HAShutdownPolicy: propertyString(ha, "shutdown_policy"),
+			Keyboard:         configString(options, "keyboard"),
+			Language:         configString(options, "language"),
+			MACPrefix:        configString(options, "mac_prefix"),
+			MaxWorkers:       configInt64(options, "max_workers"),
+			Migration: &clusterOptionsDataSourceMigrationModel{
+				Network: propertyString(migration, "network"),
+				Type:    propertyString(migration, "type"),
+			},
+		},
+	}
+
+	// set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	minVLANTag = 1
+	maxVLANTag = 4094
+
+	// maxCPUSetID is the largest CPU or node ID accepted in a CPU set, matching the highest number of CPUs a
+	// Linux kernel can be built for (CONFIG_NR_CPUS)
+	maxCPUSetID = 8191
+)
+
+// configString returns the value of the given key in a raw API configuration map as a string, or null if the
+// key is not present.
+func configString(config map[string]any, key string) types.String {
+	value, ok := config[key]
+	if !ok || value == nil {
+		return types.StringNull()
+	}
+	switch v := value.(type) {
+	case string:
+		return types.StringValue(v)
+	case float64:
+		return types.StringValue(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		return types.StringValue(fmt.Sprintf("%v", v))
+	}
+}
+
+// encodeText encodes free-form text such as a description into the single-line form Proxmox VE stores it in:
+// control characters, ':' and every byte of a non-ASCII character are percent-encoded (eg: a newline becomes
+// %0A). Unlike Proxmox VE, '%' is encoded as well so that decodeText always restores the original text.
+func encodeText(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if c := text[i]; c < 0x20 || c == ':' || c == '%' || c > 0x7e {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// decodeText reverses encodeText by decoding every valid percent-encoded byte. Unlike url.PathUnescape, a '%'
+// which is not followed by two hexadecimal digits is kept rather than making the whole value invalid.
+func decodeText(text string) string {
+	if !strings.Contains(text, "%") {
+		return text
+	}
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '%' && i+2 < len(text) {
+			if c, err := strconv.ParseUint(text[i+1:i+3], 16, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 2
+				continue
+			}
+		}
+		b.WriteByte(text[i])
+	}
+	return b.String()
+}
+
+// configInt64 returns the value of the given key in a raw API configuration map as an integer, or null if the
+// key is not present or is not numeric.
+func configInt64(config map[string]any, key string) types.Int64 {
+	switch v := config[key].(type) {
+	case float64:
+		return types.Int64Value(int64(v))
+	case string:
+		if val, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return types.Int64Value(val)
+		}
+	}
+	return types.Int64Null()
+}
+
+// uint64Value converts an unsigned API value such as a size in bytes into an integer value, saturating at the
+// maximum int64 rather than wrapping around to a negative number.
+func uint64Value(value uint64) types.Int64 {
+	if value > math.MaxInt64 {
+		return types.Int64Value(math.MaxInt64)
+	}
+	return types.Int64Value(int64(value))
+}
+
+// configBool returns the value of the given key in a raw API configuration map as a boolean. The API omits
+// some boolean options when they are disabled, so a missing key is reported as false when the prior value was
+// false and null otherwise.
+func configBool(config map[string]any, key string, prior types.Bool) types.Bool {
+	switch v := config[key].(type) {
+	case bool:
+		return types.BoolValue(v)
+	case float64:
+		return types.BoolValue(v != 0)
+	case string:
+		if val, err := strconv.ParseBool(v); err == nil {
+			return types.BoolValue(val)
+		}
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && !prior.ValueBool() {
+		return types.BoolValue(false)
+	}
+	return types.BoolNull()
+}
+
+// isValidVLANTag returns whether or not the given value is a usable 802.1Q VLAN ID.
+func isValidVLANTag(tag int64) bool {
+	return tag >= minVLANTag && tag <= maxVLANTag
+}
+
+// parseMACAddress parses a NIC MAC address, which must be a 48-bit unicast address (ie: the multicast bit of
+// the first octet is clear). Both globally unique and locally administered addresses are accepted.
+func parseMACAddress(value string) (net.HardwareAddr, error) {
+	mac, err := net.ParseMAC(strings.TrimSpace(value))
+	if err != nil || len(mac) != 6 {
+		return nil, fmt.Errorf("'%s' is not a valid 48-bit MAC address", value)
+	}
+	if mac[0]&0x01 != 0 {
+		return nil, fmt.Errorf("'%s' is a multicast MAC address", value)
+	}
+	return mac, nil
+}
+
+// isLocallyAdministered returns whether or not the U/L bit of the given MAC address is set, meaning that it was
+// assigned locally rather than by the manufacturer.
+func isLocallyAdministered(mac net.HardwareAddr) bool {
+	return len(mac) > 0 && mac[0]&0x02 != 0
+}
+
+// boolToInt converts a boolean into the 0/1 integer form expected by the API.
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// sortedConfigKeys returns the keys of an indexed configuration map (eg: net0, net1, ..., net10) ordered by
+// their prefix and then by their numeric index.
+func sortedConfigKeys(config map[string]string) []string {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		prefixI, indexI := splitConfigKey(keys[i])
+		prefixJ, indexJ := splitConfigKey(keys[j])
+		if prefixI != prefixJ {
+			return prefixI < prefixJ
+		}
+		return indexI < indexJ
+	})
+	return keys
+}
+
+// splitConfigKey splits an indexed configuration key such as 'scsi12' into its prefix and numeric index. Keys
+// without a numeric suffix are returned with an index of -1.
+func splitConfigKey(key string) (string, int) {
+	prefix := strings.TrimRight(key, "0123456789")
+	index, err := strconv.Atoi(key[len(prefix):])
+	if err != nil {
+		return key, -1
+	}
+	return prefix, index
+}
+
+// parseSize converts a size such as '32G', '512M' or '1.5T' into bytes. Units are binary (K = 1024) and a value
+// without a unit is treated as bytes.
+func parseSize(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("size is empty")
+	}
+	multiplier := float64(1)
+	switch unit := strings.ToUpper(value[len(value)-1:]); unit {
+	case "K", "M", "G", "T", "P":
+		multiplier = math.Pow(1024, float64(strings.Index("KMGTP", unit)+1))
+		value = value[:len(value)-1]
+	case "B":
+		value = value[:len(value)-1]
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("'%s' is not a valid size", value)
+	}
+	size := number * multiplier
+	// float64(math.MaxInt64) rounds up to 2^63, which itself no longer fits into an int64
+	if size >= math.MaxInt64 {
+		return 0, fmt.Errorf("'%s' exceeds the maximum supported size", value)
+	}
+	return int64(size), nil
+}
+
+// parseCPUSet expands a CPU or node set such as '0-3,8' (or '0-1;4' as used in NUMA configuration) into a
+// sorted list of unique IDs. IDs above maxCPUSetID are rejected so that a malformed range cannot expand into an
+// unbounded list.
+func parseCPUSet(value string) ([]int64, error) {
+	selected := make([]bool, maxCPUSetID+1)
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }) {
+		part = strings.TrimSpace(part)
+		start, end, isRange := strings.Cut(part, "-")
+		first, err := strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+		if err != nil || first < 0 {
+			return nil, fmt.Errorf("'%s' is not a valid CPU set entry", part)
+		}
+		last := first
+		if isRange {
+			last, err = strconv.ParseInt(strings.TrimSpace(end), 10, 64)
+			if err != nil || last < first {
+				return nil, fmt.Errorf("'%s' is not a valid CPU set range", part)
+			}
+		}
+		if last > maxCPUSetID {
+			return nil, fmt.Errorf("'%s' exceeds the maximum CPU ID of %d", part, maxCPUSetID)
+		}
+		for id := first; id <= last; id++ {
+			selected[id] = true
+		}
+	}
+	ids := []int64{}
+	for id, ok := range selected {
+		if ok {
+			ids = append(ids, int64(id))
+		}
+	}
+	return ids, nil
+}
+
+// cutProperty splits a single entry of a property string (eg: ' bridge = vmbr0') into its key and value,
+// trimming the whitespace around both. found is false when the entry has no '=', in which case the trimmed
+// entry is returned as the key.
+func cutProperty(pair string) (key, value string, found bool) {
+	key, value, found = strings.Cut(pair, "=")
+	return strings.TrimSpace(key), strings.TrimSpace(value), found
+}
+
+// parsePropertyString parses a Proxmox property string such as 'type=secure,network=10.0.0.0/24' into a map.
+// A leading entry without a key (eg: 'secure,network=...') is stored under the given default key when one is
+// provided and ignored otherwise. Keys and values are trimmed with cutProperty but not decoded: Proxmox VE only
+// percent-encodes free-form text (descriptions and comments) in its configuration files and the API returns it
+// already decoded, so decoding again would turn a literal '%25' into '%'.
+func parsePropertyString(value, defaultKey string) map[string]string {
+	properties := map[string]string{}
+	for i, pair := range strings.Split(value, ",") {
+		key, val, found := cutProperty(pair)
+		if key == "" {
+			continue
+		}
+		if !found {
+			if i == 0 && defaultKey != "" {
+				properties[defaultKey] = key
+			}
+			continue
+		}
+		properties[key] = val
+	}
+	return properties
+}
+
+// propertyString returns the value of the given key in a parsed property string, or null if it is not present.
+func propertyString(properties map[string]string, key string) types.String {
+	if value, ok := properties[key]; ok {
+		return types.StringValue(value)
+	}
+	return types.StringNull()
+}
+
+// propertyInt64 returns the value of the given key in a parsed property string as an integer, or null if it is
+// not present or is not numeric.
+func propertyInt64(properties map[string]string, key string) types.Int64 {
+	if value, ok := properties[key]; ok {
+		if val, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return types.Int64Value(val)
+		}
+	}
+	return types.Int64Null()
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCutProperty(t *testing.T) {
+	tests := []struct {
+		pair  string
+		key   string
+		value string
+		found bool
+	}{
+		{pair: "bridge=vmbr0", key: "bridge", value: "vmbr0", found: true},
+		{pair: " bridge = vmbr0 ", key: "bridge", value: "vmbr0", found: true},
+		{pair: "\tqueues=\t4", key: "queues", value: "4", found: true},
+		{pair: "comment= ", key: "comment", value: "", found: true},
+		{pair: " local-lvm:vm-100-disk-0 ", key: "local-lvm:vm-100-disk-0", value: "", found: false},
+		{pair: "opt=a=b", key: "opt", value: "a=b", found: true},
+	}
+	for _, test := range tests {
+		t.Run(test.pair, func(t *testing.T) {
+			key, value, found := cutProperty(test.pair)
+			if key != test.key || value != test.value || found != test.found {
+				t.Errorf("cutProperty(%q) = (%q, %q, %t), want (%q, %q, %t)", test.pair, key, value, found,
+					test.key, test.value, test.found)
+			}
+		})
+	}
+}
+
+func TestParsePropertyString(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		defaultKey string
+		want       map[string]string
+	}{
+		{
+			name:  "plain",
+			value: "type=secure,network=10.0.0.0/24",
+			want:  map[string]string{"type": "secure", "network": "10.0.0.0/24"},
+		},
+		{
+			name:  "padded",
+			value: " type = secure , network= 10.0.0.0/24 ",
+			want:  map[string]string{"type": "secure", "network": "10.0.0.0/24"},
+		},
+		{
+			name:       "padded default key",
+			value:      " secure , network=10.0.0.0/24",
+			defaultKey: "type",
+			want:       map[string]string{"type": "secure", "network": "10.0.0.0/24"},
+		},
+		{
+			name:  "default key ignored",
+			value: "secure,network=10.0.0.0/24",
+			want:  map[string]string{"network": "10.0.0.0/24"},
+		},
+		{
+			name:  "empty entries",
+			value: ",, type=secure ,",
+			want:  map[string]string{"type": "secure"},
+		},
+		{
+			name:  "encoded values kept",
+			value: "name=50%25,comment=a%2Cb",
+			want:  map[string]string{"name": "50%25", "comment": "a%2Cb"},
+		},
+		{
+			name:  "empty",
+			value: "",
+			want:  map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parsePropertyString(test.value, test.defaultKey); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parsePropertyString(%q, %q) = %v, want %v", test.value, test.defaultKey, got, test.want)
+			}
+		})
+	}
+}
+
+func TestConfigStringDescription(t *testing.T) {
+	// the API returns descriptions already decoded, so they must be returned exactly as received
+	for _, description := range []string{"50%25 done", "  indented\nnotes  ", "a%2Cb: c"} {
+		t.Run(description, func(t *testing.T) {
+			config := map[string]any{"description": description}
+			if got := configString(config, "description"); got != types.StringValue(description) {
+				t.Errorf("configString() = %v, want %q", got, description)
+			}
+		})
+	}
+	if got := configString(map[string]any{}, "description"); !got.IsNull() {
+		t.Errorf("configString() = %v, want null for a missing key", got)
+	}
+}
+
+func TestParseCPUSet(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []int64
+		wantErr bool
+	}{
+		{value: "0-3", want: []int64{0, 1, 2, 3}},
+		{value: "0-1", want: []int64{0, 1}},
+		{value: " 8, 0-1 ,1", want: []int64{0, 1, 8}},
+		{value: "0-1;4-5", want: []int64{0, 1, 4, 5}},
+		{value: "", want: []int64{}},
+		{value: "3-1", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: "a-b", wantErr: true},
+		{value: "8190-8191", want: []int64{8190, 8191}},
+		{value: "0-99999999999", wantErr: true},
+		{value: "8192", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			got, err := parseCPUSet(test.value)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parseCPUSet(%q) error = %v, want error %t", test.value, err, test.wantErr)
+			}
+			if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseCPUSet(%q) = %v, want %v", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestConfigBool(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		prior types.Bool
+		want  types.Bool
+	}{
+		{name: "number zero", value: float64(0), prior: types.BoolNull(), want: types.BoolValue(false)},
+		{name: "number one", value: float64(1), prior: types.BoolNull(), want: types.BoolValue(true)},
+		{name: "string zero", value: "0", prior: types.BoolNull(), want: types.BoolValue(false)},
+		{name: "string one", value: "1", prior: types.BoolValue(false), want: types.BoolValue(true)},
+		{name: "bool", value: true, prior: types.BoolNull(), want: types.BoolValue(true)},
+		{name: "unset", prior: types.BoolNull(), want: types.BoolNull()},
+		{name: "unset with prior true", prior: types.BoolValue(true), want: types.BoolNull()},
+		{name: "unset with prior false", prior: types.BoolValue(false), want: types.BoolValue(false)},
+		{name: "invalid string", value: "maybe", prior: types.BoolNull(), want: types.BoolNull()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := map[string]any{}
+			if test.value != nil {
+				config["tablet"] = test.value
+			}
+			if got := configBool(config, "tablet", test.prior); got != test.want {
+				t.Errorf("configBool(%v, %v) = %v, want %v", test.value, test.prior, got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsValidVLANTag(t *testing.T) {
+	tests := []struct {
+		tag  int64
+		want bool
+	}{
+		{tag: -1, want: false},
+		{tag: 0, want: false},
+		{tag: 1, want: true},
+		{tag: 100, want: true},
+		{tag: 4094, want: true},
+		{tag: 4095, want: false},
+	}
+	for _, test := range tests {
+		if got := isValidVLANTag(test.tag); got != test.want {
+			t.Errorf("isValidVLANTag(%d) = %t, want %t", test.tag, got, test.want)
+		}
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: "512", want: 512},
+		{value: "512B", want: 512},
+		{value: "4K", want: 4 << 10},
+		{value: "512M", want: 512 << 20},
+		{value: "32G", want: 32 << 30},
+		{value: "1.5T", want: 3 << 39},
+		{value: "4T", want: 4 << 40},
+		{value: "4398046511104", want: 4 << 40},
+		{value: "64P", want: 64 << 50},
+		{value: "8192P", wantErr: true},
+		{value: "", wantErr: true},
+		{value: "-1G", wantErr: true},
+		{value: "lots", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			got, err := parseSize(test.value)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parseSize(%q) error = %v, want error %t", test.value, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("parseSize(%q) = %d, want %d", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestEncodeText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "plain text", want: "plain text"},
+		{text: "line one\nline two", want: "line one%0Aline two"},
+		{text: "tab\there\r\n", want: "tab%09here%0D%0A"},
+		{text: "owner: ops", want: "owner%3A ops"},
+		{text: "100% done", want: "100%25 done"},
+		{text: "café", want: "caf%C3%A9"},
+		{text: "a,b=c;d#e", want: "a,b=c;d#e"},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			if got := encodeText(test.text); got != test.want {
+				t.Errorf("encodeText(%q) = %q, want %q", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "line one%0Aline two", want: "line one\nline two"},
+		{text: "owner%3a ops", want: "owner: ops"},
+		{text: "caf%C3%A9", want: "café"},
+		{text: "100% done", want: "100% done"},
+		{text: "trailing %", want: "trailing %"},
+		{text: "short %4", want: "short %4"},
+		{text: "%zz", want: "%zz"},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			if got := decodeText(test.text); got != test.want {
+				t.Errorf("decodeText(%q) = %q, want %q", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestEncodeTextRoundTrip(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"  leading and trailing spaces  ",
+		"# Web server\n\nOwner: ops@example.com\nCost center: 42%\n",
+		"windows\r\nline endings",
+		"special !\"#$&'()*+,/;<=>?@[\\]^_`{|}~ characters",
+		"already encoded %0A and %25",
+		"unicode: 日本語 ✓",
+	} {
+		t.Run(text, func(t *testing.T) {
+			encoded := encodeText(text)
+			if strings.ContainsAny(encoded, "\r\n") {
+				t.Errorf("encodeText(%q) = %q, want a single line", text, encoded)
+			}
+			if got := decodeText(encoded); got != text {
+				t.Errorf("decodeText(encodeText(%q)) = %q", text, got)
+			}
+		})
+	}
+}
+
+func TestParseMACAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		wantLocal bool
+		wantErr   string
+	}{
+		{name: "globally unique", value: "00:1A:2B:3C:4D:5E"},
+		{name: "locally administered", value: "52:54:00:12:34:56", wantLocal: true},
+		{name: "Proxmox OUI", value: "BC:24:11:AA:BB:CC"},
+		{name: "padded and dashed", value: " 02-00-00-00-00-01 ", wantLocal: true},
+		{name: "multicast", value: "01:00:5E:00:00:01", wantErr: "is a multicast MAC address"},
+		{name: "wrong length", value: "00:1A:2B:3C:4D", wantErr: "is not a valid 48-bit MAC address"},
+		{name: "EUI-64", value: "00:1A:2B:FF:FE:3C:4D:5E", wantErr: "is not a valid 48-bit MAC address"},
+		{name: "not hex", value: "00:1A:2B:3C:4D:ZZ", wantErr: "is not a valid 48-bit MAC address"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mac, err := parseMACAddress(test.value)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("parseMACAddress(%q) error = %v, want an error containing %q", test.value, err,
+						test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMACAddress(%q) unexpected error: %v", test.value, err)
+			}
+			if got := isLocallyAdministered(mac); got != test.wantLocal {
+				t.Errorf("isLocallyAdministered(%s) = %t, want %t", mac, got, test.wantLocal)
+			}
+		})
+	}
+}
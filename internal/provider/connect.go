@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// connectTimeout caps how long the initial connection to the API is retried so that a cluster which is really
+// down still fails quickly.
+const connectTimeout = 30 * time.Second
+
+// versionWithRetry retrieves the Proxmox VE version, retrying transient failures with the same backoff used to
+// poll tasks until the connect timeout is reached.
+func versionWithRetry(ctx context.Context, client *proxmox.Client, poll taskPollSettings) (*proxmox.Version,
+	error) {
+
+	deadline := time.Now().Add(connectTimeout)
+	interval := poll.minInterval
+	for attempt := 1; ; attempt++ {
+		version, err := client.Version(ctx)
+		if err == nil || !isTransientConnectError(err) || time.Now().Add(interval).After(deadline) {
+			return version, err
+		}
+		tflog.Warn(ctx, "failed to connect to Proxmox VE server; retrying", map[string]any{
+			"attempt":  attempt,
+			"interval": interval.String(),
+			"error":    err.Error(),
+		})
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		interval = poll.nextInterval(interval)
+	}
+}
+
+// isTransientConnectError returns whether or not the given error from the initial API request may go away on
+// its own. Authentication and certificate problems as well as cancellation are never retried.
+func isTransientConnectError(err error) bool {
+	var mismatch *tlsFingerprintMismatchError
+	var certErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	switch {
+	case proxmox.IsNotAuthorized(err),
+		errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &mismatch),
+		errors.As(err, &certErr),
+		errors.As(err, &authorityErr),
+		errors.As(err, &hostnameErr),
+		errors.As(err, &invalidErr):
+		return false
+	}
+	return true
+}
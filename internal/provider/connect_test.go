@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+func TestVersionWithRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		failStatus   int
+		wantRequests int
+		wantErr      bool
+	}{
+		{name: "succeeds", wantRequests: 1},
+		{name: "fails once then succeeds", failures: 1, failStatus: http.StatusServiceUnavailable, wantRequests: 2},
+		{name: "not authorized", failures: 1, failStatus: http.StatusUnauthorized, wantRequests: 1, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= test.failures {
+					http.Error(w, http.StatusText(test.failStatus), test.failStatus)
+					return
+				}
+				writeTestData(t, w, map[string]any{"version": "8.2.4", "release": "8.2", "repoid": "faa83925"})
+			})
+			poll := taskPollSettings{minInterval: time.Millisecond, maxInterval: time.Millisecond, multiplier: 1}
+			version, err := versionWithRetry(context.Background(), data.client, poll)
+			if requests != test.wantRequests {
+				t.Errorf("made %d requests, want %d", requests, test.wantRequests)
+			}
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("versionWithRetry() unexpectedly succeeded")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("versionWithRetry() unexpected error: %v", err)
+			}
+			if version.Version != "8.2.4" {
+				t.Errorf("version = %q, want 8.2.4", version.Version)
+			}
+		})
+	}
+}
+
+func TestIsTransientConnectError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: errors.New("dial tcp 10.0.0.1:8006: connect: connection refused"), want: true},
+		{err: errors.New("503 Service Unavailable"), want: true},
+		{err: proxmox.ErrNotAuthorized, want: false},
+		{err: context.Canceled, want: false},
+		{err: fmt.Errorf("request: %w", context.DeadlineExceeded), want: false},
+		{err: fmt.Errorf("tls: %w", x509.UnknownAuthorityError{}), want: false},
+		{err: fmt.Errorf("tls: %w", x509.HostnameError{Certificate: &x509.Certificate{}, Host: "pve1"}), want: false},
+		{err: &tlsFingerprintMismatchError{}, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			if got := isTransientConnectError(test.err); got != test.want {
+				t.Errorf("isTransientConnectError(%v) = %t, want %t", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &decodeDescriptionFunction{}
+)
+
+func NewDecodeDescriptionFunction() function.Function {
+	return &decodeDescriptionFunction{}
+}
+
+type decodeDescriptionFunction struct{}
+
+func (f *decodeDescriptionFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "decode_description"
+}
+
+func (f *decodeDescriptionFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Decodes a description stored by Proxmox VE",
+		Description: "Converts the percent-encoded single-line form Proxmox VE uses to store the notes of VMs, " +
+			"containers, pools and storage back into readable text. A '%' which does not start a valid encoding " +
+			"is kept as is. An error is returned if the decoded text is not valid UTF-8.",
+		MarkdownDescription: "Converts the percent-encoded single-line form Proxmox VE uses to store the notes of " +
+			"VMs, containers, pools and storage back into readable text. A `%` which does not start a valid " +
+			"encoding is kept as is. An error is returned if the decoded text is not valid UTF-8.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "encoded",
+				Description: "Description as stored by Proxmox VE",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *decodeDescriptionFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var encoded string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &encoded))
+	if resp.Error != nil {
+		return
+	}
+
+	text := decodeText(encoded)
+	if !utf8.ValidString(text) {
+		resp.Error = function.NewArgumentFuncError(0, "The decoded description is not valid UTF-8 text.")
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, text))
+}
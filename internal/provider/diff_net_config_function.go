@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nicModels are the network device models which may be used as the key of the first property of a NIC
+// configuration string, with the MAC address as the value (eg: virtio=BC:24:11:00:00:01).
+var nicModels = []string{
+	"e1000", "e1000-82540em", "e1000-82544gc", "e1000-82545em", "e1000e", "i82551", "i82557b", "i82559er",
+	"ne2k_isa", "ne2k_pci", "pcnet", "rtl8139", "virtio", "vmxnet3",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &diffNetConfigFunction{}
+)
+
+func NewDiffNetConfigFunction() function.Function {
+	return &diffNetConfigFunction{}
+}
+
+type diffNetConfigFunction struct{}
+
+// netConfigDiff describes the differences between two NIC configuration strings.
+type netConfigDiff struct {
+	Added   []string `tfsdk:"added"`
+	Changed []string `tfsdk:"changed"`
+	Equal   bool     `tfsdk:"equal"`
+	Removed []string `tfsdk:"removed"`
+}
+
+func (f *diffNetConfigFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "diff_net_config"
+}
+
+func (f *diffNetConfigFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Compares two network interface configuration strings",
+		Description: "Compares two raw network interface configuration strings (eg: " +
+			"'virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=10') and returns an object with the sorted lists of keys " +
+			"which were added, removed or changed going from the first to the second, and whether they are equal. " +
+			"Key order, whitespace and the case of MAC addresses are ignored, and the 'model=MAC' shorthand is " +
+			"compared as separate model and macaddr keys.",
+		MarkdownDescription: "Compares two raw network interface configuration strings (eg: " +
+			"`virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=10`) and returns an object with the sorted lists of keys " +
+			"which were `added`, `removed` or `changed` going from the first to the second, and whether they are " +
+			"`equal`. Key order, whitespace and the case of MAC addresses are ignored, and the `model=MAC` " +
+			"shorthand is compared as separate `model` and `macaddr` keys.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "old",
+				Description: "Original network interface configuration",
+			},
+			function.StringParameter{
+				Name:        "new",
+				Description: "Network interface configuration to compare against the original",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"added":   types.ListType{ElemType: types.StringType},
+				"changed": types.ListType{ElemType: types.StringType},
+				"equal":   types.BoolType,
+				"removed": types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (f *diffNetConfigFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var oldConfig, newConfig string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &oldConfig, &newConfig))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, diffNetConfig(oldConfig, newConfig)))
+}
+
+// diffNetConfig compares the normalized properties of two NIC configuration strings.
+func diffNetConfig(oldConfig, newConfig string) netConfigDiff {
+	oldProperties := normalizeNetConfig(oldConfig)
+	newProperties := normalizeNetConfig(newConfig)
+
+	diff := netConfigDiff{
+		Added:   []string{},
+		Changed: []string{},
+		Removed: []string{},
+	}
+	for key, oldValue := range oldProperties {
+		newValue, ok := newProperties[key]
+		if !ok {
+			diff.Removed = append(diff.Removed, key)
+		} else if newValue != oldValue {
+			diff.Changed = append(diff.Changed, key)
+		}
+	}
+	for key := range newProperties {
+		if _, ok := oldProperties[key]; !ok {
+			diff.Added = append(diff.Added, key)
+		}
+	}
+	slices.Sort(diff.Added)
+	slices.Sort(diff.Changed)
+	slices.Sort(diff.Removed)
+	diff.Equal = len(diff.Added) == 0 && len(diff.Changed) == 0 && len(diff.Removed) == 0
+	return diff
+}
+
+// normalizeNetConfig parses a NIC configuration string into its properties, splitting the 'model=MAC'
+// shorthand into separate model and macaddr properties and upper-casing MAC addresses.
+func normalizeNetConfig(config string) map[string]string {
+	properties := parsePropertyString(config, "")
+	for key, value := range properties {
+		if slices.Contains(nicModels, key) {
+			delete(properties, key)
+			properties["model"] = key
+			if value != "" {
+				properties["macaddr"] = value
+			}
+		}
+	}
+	if mac, ok := properties["macaddr"]; ok {
+		properties["macaddr"] = strings.ToUpper(mac)
+	}
+	return properties
+}
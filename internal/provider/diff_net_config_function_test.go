@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffNetConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldConfig string
+		newConfig string
+		want      netConfigDiff
+	}{
+		{
+			name:      "reordered",
+			oldConfig: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,firewall=1,tag=100",
+			newConfig: "tag=100, firewall=1 ,bridge=vmbr0,virtio=bc:24:11:aa:bb:cc",
+			want:      netConfigDiff{Added: []string{}, Changed: []string{}, Equal: true, Removed: []string{}},
+		},
+		{
+			name:      "model and address split",
+			oldConfig: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0",
+			newConfig: "bridge=vmbr0,model=virtio,macaddr=BC:24:11:AA:BB:CC",
+			want:      netConfigDiff{Added: []string{}, Changed: []string{}, Equal: true, Removed: []string{}},
+		},
+		{
+			name:      "added, changed and removed",
+			oldConfig: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,firewall=1",
+			newConfig: "e1000=BC:24:11:AA:BB:CC,bridge=vmbr1,tag=20,mtu=1",
+			want: netConfigDiff{
+				Added:   []string{"mtu", "tag"},
+				Changed: []string{"bridge", "model"},
+				Removed: []string{"firewall"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := diffNetConfig(test.oldConfig, test.newConfig); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("diffNetConfig(%q, %q) = %+v, want %+v", test.oldConfig, test.newConfig, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &downloadFileResource{}
+	_ resource.ResourceWithConfigure      = &downloadFileResource{}
+	_ resource.ResourceWithValidateConfig = &downloadFileResource{}
+)
+
+func NewDownloadFileResource() resource.Resource {
+	return &downloadFileResource{}
+}
+
+type downloadFileResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type downloadFileResourceModel struct {
+	Checksum          types.String `tfsdk:"checksum"`
+	ChecksumAlgorithm types.String `tfsdk:"checksum_algorithm"`
+	ContentType       types.String `tfsdk:"content_type"`
+	Filename          types.String `tfsdk:"filename"`
+	NodeName          types.String `tfsdk:"node_name"`
+	Size              types.Int64  `tfsdk:"size"`
+	Storage           types.String `tfsdk:"storage"`
+	URL               types.String `tfsdk:"url"`
+	VolumeID          types.String `tfsdk:"volume_id"`
+}
+
+// storageContent is a single entry from a storage's content list.
+type storageContent struct {
+	Size  uint64 `json:"size"`
+	VolID string `json:"volid"`
+}
+
+func (r *downloadFileResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *downloadFileResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_download_file"
+}
+
+func (r *downloadFileResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	requiresReplace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+	resp.Schema = schema.Schema{
+		Description: "Downloads an ISO image or container template from a URL to a storage. Changing any " +
+			"attribute downloads the file again.",
+		MarkdownDescription: "Downloads an ISO image or container template from a URL to a storage. Changing " +
+			"any attribute downloads the file again.",
+		Attributes: map[string]schema.Attribute{
+			"checksum": schema.StringAttribute{
+				Description:         "Expected checksum of the file, verified by the server after the download",
+				MarkdownDescription: "Expected checksum of the file, verified by the server after the download",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				Description: "Algorithm of the checksum: md5, sha1, sha224, sha256, sha384 or sha512",
+				MarkdownDescription: "Algorithm of the checksum: `md5`, `sha1`, `sha224`, `sha256`, `sha384` " +
+					"or `sha512`",
+				Optional:      true,
+				PlanModifiers: requiresReplace,
+			},
+			"content_type": schema.StringAttribute{
+				Description:         "Content type of the file, either 'iso' or 'vztmpl'",
+				MarkdownDescription: "Content type of the file, either `iso` or `vztmpl`",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"filename": schema.StringAttribute{
+				Description:         "Name of the file in the storage",
+				MarkdownDescription: "Name of the file in the storage",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"node_name": schema.StringAttribute{
+				Description:         "Name of the node which downloads the file",
+				MarkdownDescription: "Name of the node which downloads the file",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"size": schema.Int64Attribute{
+				Description:         "Size of the downloaded file in bytes",
+				MarkdownDescription: "Size of the downloaded file in bytes",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"storage": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: requiresReplace,
+			},
+			"url": schema.StringAttribute{
+				Description:         "URL to download the file from",
+				MarkdownDescription: "URL to download the file from",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"volume_id": schema.StringAttribute{
+				Description:         "Volume ID of the downloaded file (eg: local:iso/debian.iso)",
+				MarkdownDescription: "Volume ID of the downloaded file (eg: `local:iso/debian.iso`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *downloadFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config downloadFileResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ContentType.IsUnknown() {
+		switch config.ContentType.ValueString() {
+		case "iso", "vztmpl":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content_type"),
+				"Unsupported Content Type",
+				fmt.Sprintf("The content type '%s' is not supported; it must be either 'iso' or 'vztmpl'.",
+					config.ContentType.ValueString()),
+			)
+		}
+	}
+	if config.Checksum.IsNull() != config.ChecksumAlgorithm.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("checksum_algorithm"),
+			"Incomplete Checksum",
+			"The checksum and checksum_algorithm attributes must be specified together.",
+		)
+	}
+}
+
+func (r *downloadFileResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan downloadFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// start the download and wait for it to finish; the server verifies the checksum as part of the task
+	nodeName := plan.NodeName.ValueString()
+	storage := plan.Storage.ValueString()
+	fileURL := plan.URL.ValueString()
+	params := map[string]any{
+		"content":  plan.ContentType.ValueString(),
+		"filename": plan.Filename.ValueString(),
+		"url":      fileURL,
+	}
+	if !plan.Checksum.IsNull() {
+		params["checksum"] = plan.Checksum.ValueString()
+		params["checksum-algorithm"] = plan.ChecksumAlgorithm.ValueString()
+	}
+	var upid proxmox.UPID
+	err := r.providerData.client.Post(ctx, fmt.Sprintf("/nodes/%s/storage/%s/download-url",
+		url.PathEscape(nodeName), url.PathEscape(storage)), params, &upid)
+	if err == nil {
+		err = r.providerData.waitForTask(ctx, proxmox.NewTask(upid, r.providerData.client))
+	}
+	if err != nil {
+		tflog.Error(ctx, "failed to download file", map[string]any{
+			"node_name": nodeName,
+			"storage":   storage,
+			"url":       fileURL,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Download File",
+			fmt.Sprintf("Failed to download '%s' to the storage '%s' on the cluster node '%s':\n\t%s", fileURL,
+				storage, nodeName, err.Error()),
+		)
+		return
+	}
+
+	// look up the downloaded file
+	content, err := r.findContent(ctx, plan)
+	if err == nil && content == nil {
+		err = fmt.Errorf("the file '%s' was not found after the download completed", r.volumeID(plan))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Downloaded File",
+			fmt.Sprintf("Failed to retrieve the downloaded file from the storage '%s':\n\t%s", storage,
+				err.Error()),
+		)
+		return
+	}
+	plan.Size = uint64Value(content.Size)
+	plan.VolumeID = types.StringValue(content.VolID)
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *downloadFileResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state downloadFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure the file still exists
+	content, err := r.findContent(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Downloaded File",
+			fmt.Sprintf("Failed to retrieve the content of the storage '%s':\n\t%s", state.Storage.ValueString(),
+				err.Error()),
+		)
+		return
+	}
+	if content == nil {
+		tflog.Warn(ctx, "downloaded file no longer exists", map[string]any{"volume_id": r.volumeID(state)})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Size = uint64Value(content.Size)
+	state.VolumeID = types.StringValue(content.VolID)
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *downloadFileResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan; all configurable attributes require replacement so there is nothing to update
+	var plan downloadFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *downloadFileResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state downloadFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// delete the file
+	nodeName := state.NodeName.ValueString()
+	storage := state.Storage.ValueString()
+	volumeID := state.VolumeID.ValueString()
+	var upid proxmox.UPID
+	err := r.providerData.client.Delete(ctx, fmt.Sprintf("/nodes/%s/storage/%s/content/%s",
+		url.PathEscape(nodeName), url.PathEscape(storage), url.PathEscape(volumeID)), &upid)
+	if err == nil && upid != "" {
+		err = r.providerData.waitForTask(ctx, proxmox.NewTask(upid, r.providerData.client))
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Delete Downloaded File",
+			fmt.Sprintf("Failed to delete the file '%s':\n\t%s", volumeID, err.Error()),
+		)
+		return
+	}
+}
+
+// volumeID returns the volume ID the downloaded file is expected to have.
+func (r *downloadFileResource) volumeID(model downloadFileResourceModel) string {
+	return fmt.Sprintf("%s:%s/%s", model.Storage.ValueString(), model.ContentType.ValueString(),
+		model.Filename.ValueString())
+}
+
+// findContent returns the storage content entry of the downloaded file, or nil if it does not exist.
+func (r *downloadFileResource) findContent(ctx context.Context, model downloadFileResourceModel) (
+	*storageContent, error) {
+
+	var contents []storageContent
+	err := r.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/storage/%s/content?content=%s",
+		url.PathEscape(model.NodeName.ValueString()), url.PathEscape(model.Storage.ValueString()),
+		url.QueryEscape(model.ContentType.ValueString())), &contents)
+	if err != nil {
+		return nil, err
+	}
+	volumeID := r.volumeID(model)
+	for i := range contents {
+		if contents[i].VolID == volumeID {
+			return &contents[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &encodeDescriptionFunction{}
+)
+
+func NewEncodeDescriptionFunction() function.Function {
+	return &encodeDescriptionFunction{}
+}
+
+type encodeDescriptionFunction struct{}
+
+func (f *encodeDescriptionFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "encode_description"
+}
+
+func (f *encodeDescriptionFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Encodes a description into the form Proxmox VE stores it in",
+		Description: "Converts multi-line text into the single-line form Proxmox VE uses to store the notes of " +
+			"VMs, containers, pools and storage: control characters such as newlines, colons, percent signs and " +
+			"non-ASCII characters are percent-encoded (eg: a newline becomes %0A). Use decode_description to " +
+			"reverse it.",
+		MarkdownDescription: "Converts multi-line text into the single-line form Proxmox VE uses to store the " +
+			"notes of VMs, containers, pools and storage: control characters such as newlines, colons, percent " +
+			"signs and non-ASCII characters are percent-encoded (eg: a newline becomes `%0A`). Use " +
+			"`decode_description` to reverse it.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "Description to encode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *encodeDescriptionFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var text string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &text))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, encodeText(text)))
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDescriptionFunctionsRoundTrip(t *testing.T) {
+	run := func(f function.Function, value string) string {
+		t.Helper()
+		req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(value)})}
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		f.Run(context.Background(), req, &resp)
+		if resp.Error != nil {
+			t.Fatalf("Run(%q) unexpected error: %v", value, resp.Error)
+		}
+		return resp.Result.Value().(types.String).ValueString()
+	}
+
+	text := "# Web server\n\nOwner: ops@example.com\nUsage: 75% of quota\n"
+	encoded := run(&encodeDescriptionFunction{}, text)
+	if want := "# Web server%0A%0AOwner%3A ops@example.com%0AUsage%3A 75%25 of quota%0A"; encoded != want {
+		t.Errorf("encode_description() = %q, want %q", encoded, want)
+	}
+	if decoded := run(&decodeDescriptionFunction{}, encoded); decoded != text {
+		t.Errorf("decode_description(encode_description()) = %q, want %q", decoded, text)
+	}
+}
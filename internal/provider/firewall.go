@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	clusterFirewallPath = "/cluster/firewall"
+
+	firewallScopeCluster = "cluster"
+	firewallScopeVM      = "vm"
+)
+
+// firewallScopeFilterModel selects whether cluster-wide or VM-specific firewall objects are used.
+type firewallScopeFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	Scope    types.String `tfsdk:"scope"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+// firewallScopeFilterSchema returns the schema for a firewallScopeFilterModel filter.
+func firewallScopeFilterSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"node_name": schema.StringAttribute{
+				Description: "Name of the node hosting the VM when the scope is 'vm'; defaults to the provider's " +
+					"default_node when omitted",
+				MarkdownDescription: "Name of the node hosting the VM when the scope is `vm`; defaults to the " +
+					"provider's `default_node` when omitted",
+				Optional: true,
+			},
+			"scope": schema.StringAttribute{
+				Description:         "Either 'cluster' (the default) or 'vm'",
+				MarkdownDescription: "Either `cluster` (the default) or `vm`",
+				Optional:            true,
+			},
+			"vm_id": schema.Int32Attribute{
+				Description:         "ID of the VM when the scope is 'vm'",
+				MarkdownDescription: "ID of the VM when the scope is `vm`",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// firewallBasePath returns the API path of the firewall for the scope selected by the given filter, adding an
+// error to the diagnostics if the filter is invalid.
+func (p *proxmoxveProviderData) firewallBasePath(filter *firewallScopeFilterModel,
+	diags *diag.Diagnostics) string {
+
+	if filter == nil || filter.Scope.IsNull() || filter.Scope.ValueString() == firewallScopeCluster {
+		return clusterFirewallPath
+	}
+	if filter.Scope.ValueString() != firewallScopeVM {
+		diags.AddError(
+			"Invalid Firewall Scope",
+			fmt.Sprintf("The firewall scope '%s' is not supported; it must be either '%s' or '%s'.",
+				filter.Scope.ValueString(), firewallScopeCluster, firewallScopeVM),
+		)
+		return ""
+	}
+	nodeName := p.NodeName(filter.NodeName)
+	if nodeName == "" {
+		diags.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name when using the 'vm' "+
+				"firewall scope or configure a default node for the provider.",
+		)
+		return ""
+	}
+	if filter.VMID.IsNull() || filter.VMID.IsUnknown() {
+		diags.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID when using the 'vm' firewall scope.",
+		)
+		return ""
+	}
+	return fmt.Sprintf("/nodes/%s/qemu/%d/firewall", url.PathEscape(nodeName), filter.VMID.ValueInt32())
+}
+
+// firewallIPSet is a single entry from a firewall IPSet list endpoint.
+type firewallIPSet struct {
+	Comment string `json:"comment"`
+	Name    string `json:"name"`
+}
+
+// firewallIPSetEntries returns the raw entries of the IPSet with the given name below the given firewall path,
+// sorted by CIDR.
+func (p *proxmoxveProviderData) firewallIPSetEntries(ctx context.Context, basePath, name string) (
+	[]map[string]any, error) {
+
+	var entries []map[string]any
+	err := p.client.Get(ctx, fmt.Sprintf("%s/ipset/%s", basePath, url.PathEscape(name)), &entries)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return configString(entries[i], "cidr").ValueString() < configString(entries[j], "cidr").ValueString()
+	})
+	return entries, nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &firewallAliasesDataSource{}
+	_ datasource.DataSourceWithConfigure = &firewallAliasesDataSource{}
+)
+
+func NewFirewallAliasesDataSource() datasource.DataSource {
+	return &firewallAliasesDataSource{}
+}
+
+type firewallAliasesDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type firewallAliasesDataSourceModel struct {
+	Data   []firewallAliasesDataSourceAliasModel `tfsdk:"data"`
+	Filter *firewallScopeFilterModel             `tfsdk:"filter"`
+}
+
+type firewallAliasesDataSourceAliasModel struct {
+	CIDR    types.String `tfsdk:"cidr"`
+	Comment types.String `tfsdk:"comment"`
+	Name    types.String `tfsdk:"name"`
+}
+
+// firewallAlias is a single entry from a firewall aliases endpoint.
+type firewallAlias struct {
+	CIDR    string `json:"cidr"`
+	Comment string `json:"comment"`
+	Name    string `json:"name"`
+}
+
+func (d *firewallAliasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *firewallAliasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_firewall_aliases"
+}
+
+func (d *firewallAliasesDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Computed: true,
+						},
+						"comment": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": firewallScopeFilterSchema(),
+		},
+	}
+}
+
+func (d *firewallAliasesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config firewallAliasesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the aliases
+	basePath := d.providerData.firewallBasePath(config.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var aliases []firewallAlias
+	if err := d.providerData.client.Get(ctx, basePath+"/aliases", &aliases); err != nil {
+		tflog.Error(ctx, "failed to retrieve firewall aliases", map[string]any{
+			"path":  basePath,
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Firewall Aliases",
+			fmt.Sprintf("Failed to retrieve the firewall aliases:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := firewallAliasesDataSourceModel{
+		Data:   []firewallAliasesDataSourceAliasModel{},
+		Filter: config.Filter,
+	}
+	for _, alias := range aliases {
+		state.Data = append(state.Data, firewallAliasesDataSourceAliasModel{
+			CIDR:    types.StringValue(alias.CIDR),
+			Comment: types.StringValue(alias.Comment),
+			Name:    types.StringValue(alias.Name),
+		})
+	}
+	sort.Slice(state.Data, func(i, j int) bool {
+		return state.Data[i].Name.ValueString() < state.Data[j].Name.ValueString()
+	})
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
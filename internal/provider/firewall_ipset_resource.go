@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &firewallIPSetResource{}
+	_ resource.ResourceWithConfigure   = &firewallIPSetResource{}
+	_ resource.ResourceWithImportState = &firewallIPSetResource{}
+)
+
+func NewFirewallIPSetResource() resource.Resource {
+	return &firewallIPSetResource{}
+}
+
+type firewallIPSetResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type firewallIPSetResourceModel struct {
+	Comment types.String                      `tfsdk:"comment"`
+	Entries []firewallIPSetResourceEntryModel `tfsdk:"entries"`
+	Name    types.String                      `tfsdk:"name"`
+}
+
+type firewallIPSetResourceEntryModel struct {
+	CIDR    types.String `tfsdk:"cidr"`
+	Comment types.String `tfsdk:"comment"`
+	NoMatch types.Bool   `tfsdk:"nomatch"`
+}
+
+func (r *firewallIPSetResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *firewallIPSetResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_firewall_ipset"
+}
+
+func (r *firewallIPSetResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a cluster-wide firewall IPSet and its entries. Existing IPSets are imported using " +
+			"their name.",
+		MarkdownDescription: "Manages a cluster-wide firewall IPSet and its entries. Existing IPSets are imported " +
+			"using their `name`.",
+		Attributes: map[string]schema.Attribute{
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"entries": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Description:         "IP address or network in CIDR notation",
+							MarkdownDescription: "IP address or network in CIDR notation",
+							Required:            true,
+						},
+						"comment": schema.StringAttribute{
+							Optional: true,
+						},
+						"nomatch": schema.BoolAttribute{
+							Description:         "Exclude the address or network from the IPSet",
+							MarkdownDescription: "Exclude the address or network from the IPSet",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the IPSet; changing it forces a new IPSet",
+				MarkdownDescription: "Name of the IPSet; changing it forces a new IPSet",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *firewallIPSetResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan firewallIPSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// create the IPSet and its entries
+	name := plan.Name.ValueString()
+	params := map[string]any{"name": name}
+	if !plan.Comment.IsNull() {
+		params["comment"] = plan.Comment.ValueString()
+	}
+	if err := r.providerData.client.Post(ctx, clusterFirewallPath+"/ipset", params, nil); err != nil {
+		tflog.Error(ctx, "failed to create firewall IPSet", map[string]any{
+			"name":  name,
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Create Firewall IPSet",
+			fmt.Sprintf("Failed to create the firewall IPSet '%s':\n\t%s", name, err.Error()),
+		)
+		return
+	}
+	for _, entry := range plan.Entries {
+		if err := r.addEntry(ctx, name, entry); err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Create Firewall IPSet",
+				fmt.Sprintf("Failed to add the entry '%s' to the firewall IPSet '%s':\n\t%s",
+					entry.CIDR.ValueString(), name, err.Error()),
+			)
+			return
+		}
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *firewallIPSetResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state firewallIPSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the IPSet; the list endpoint is the only one which returns its comment
+	name := state.Name.ValueString()
+	var ipsets []firewallIPSet
+	if err := r.providerData.client.Get(ctx, clusterFirewallPath+"/ipset", &ipsets); err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Firewall IPSet",
+			fmt.Sprintf("Failed to retrieve the firewall IPSet '%s':\n\t%s", name, err.Error()),
+		)
+		return
+	}
+	var ipset *firewallIPSet
+	for i := range ipsets {
+		if ipsets[i].Name == name {
+			ipset = &ipsets[i]
+			break
+		}
+	}
+	if ipset == nil {
+		tflog.Warn(ctx, "firewall IPSet no longer exists", map[string]any{"name": name})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	entries, err := r.providerData.firewallIPSetEntries(ctx, clusterFirewallPath, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Firewall IPSet",
+			fmt.Sprintf("Failed to retrieve the entries of the firewall IPSet '%s':\n\t%s", name, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model, using the prior entries to decide how omitted flags are reported
+	prior := map[string]firewallIPSetResourceEntryModel{}
+	for _, entry := range state.Entries {
+		prior[entry.CIDR.ValueString()] = entry
+	}
+	state.Comment = types.StringNull()
+	if ipset.Comment != "" {
+		state.Comment = types.StringValue(ipset.Comment)
+	}
+	state.Entries = nil
+	for _, entry := range entries {
+		cidr := configString(entry, "cidr")
+		state.Entries = append(state.Entries, firewallIPSetResourceEntryModel{
+			CIDR:    cidr,
+			Comment: configString(entry, "comment"),
+			NoMatch: configBool(entry, "nomatch", prior[cidr.ValueString()].NoMatch),
+		})
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *firewallIPSetResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan and state
+	var plan, state firewallIPSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update the comment; renaming an IPSet to its own name is how the API updates it
+	name := plan.Name.ValueString()
+	if !plan.Comment.Equal(state.Comment) {
+		params := map[string]any{
+			"name":    name,
+			"rename":  name,
+			"comment": plan.Comment.ValueString(),
+		}
+		if err := r.providerData.client.Post(ctx, clusterFirewallPath+"/ipset", params, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Update Firewall IPSet",
+				fmt.Sprintf("Failed to update the firewall IPSet '%s':\n\t%s", name, err.Error()),
+			)
+			return
+		}
+	}
+
+	// remove entries which are no longer planned before adding or updating the others
+	planned := map[string]firewallIPSetResourceEntryModel{}
+	for _, entry := range plan.Entries {
+		planned[entry.CIDR.ValueString()] = entry
+	}
+	existing := map[string]firewallIPSetResourceEntryModel{}
+	for _, entry := range state.Entries {
+		cidr := entry.CIDR.ValueString()
+		existing[cidr] = entry
+		if _, ok := planned[cidr]; ok {
+			continue
+		}
+		if err := r.deleteEntry(ctx, name, cidr); err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Update Firewall IPSet",
+				fmt.Sprintf("Failed to remove the entry '%s' from the firewall IPSet '%s':\n\t%s", cidr, name,
+					err.Error()),
+			)
+			return
+		}
+	}
+	for _, entry := range plan.Entries {
+		cidr := entry.CIDR.ValueString()
+		current, ok := existing[cidr]
+		var err error
+		switch {
+		case !ok:
+			err = r.addEntry(ctx, name, entry)
+		case !current.Comment.Equal(entry.Comment) || !current.NoMatch.Equal(entry.NoMatch):
+			err = r.updateEntry(ctx, name, entry)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Update Firewall IPSet",
+				fmt.Sprintf("Failed to update the entry '%s' of the firewall IPSet '%s':\n\t%s", cidr, name,
+					err.Error()),
+			)
+			return
+		}
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *firewallIPSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state firewallIPSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the API refuses to delete an IPSet which still has entries
+	name := state.Name.ValueString()
+	entries, err := r.providerData.firewallIPSetEntries(ctx, clusterFirewallPath, name)
+	if err == nil {
+		for _, entry := range entries {
+			if err = r.deleteEntry(ctx, name, configString(entry, "cidr").ValueString()); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		err = r.providerData.client.Delete(ctx,
+			fmt.Sprintf("%s/ipset/%s", clusterFirewallPath, url.PathEscape(name)), nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Delete Firewall IPSet",
+			fmt.Sprintf("Failed to delete the firewall IPSet '%s':\n\t%s", name, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *firewallIPSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// addEntry adds the given entry to the IPSet with the given name.
+func (r *firewallIPSetResource) addEntry(ctx context.Context, name string,
+	entry firewallIPSetResourceEntryModel) error {
+
+	params := r.entryParams(entry)
+	params["cidr"] = entry.CIDR.ValueString()
+	return r.providerData.client.Post(ctx,
+		fmt.Sprintf("%s/ipset/%s", clusterFirewallPath, url.PathEscape(name)), params, nil)
+}
+
+// updateEntry updates the comment and flags of the given entry in the IPSet with the given name.
+func (r *firewallIPSetResource) updateEntry(ctx context.Context, name string,
+	entry firewallIPSetResourceEntryModel) error {
+
+	params := r.entryParams(entry)
+	if _, ok := params["comment"]; !ok {
+		params["comment"] = ""
+	}
+	params["nomatch"] = boolToInt(entry.NoMatch.ValueBool())
+	return r.providerData.client.Put(ctx, fmt.Sprintf("%s/ipset/%s/%s", clusterFirewallPath,
+		url.PathEscape(name), url.PathEscape(entry.CIDR.ValueString())), params, nil)
+}
+
+// deleteEntry removes the entry with the given CIDR from the IPSet with the given name.
+func (r *firewallIPSetResource) deleteEntry(ctx context.Context, name, cidr string) error {
+	return r.providerData.client.Delete(ctx, fmt.Sprintf("%s/ipset/%s/%s", clusterFirewallPath,
+		url.PathEscape(name), url.PathEscape(cidr)), nil)
+}
+
+// entryParams converts the optional attributes of an entry into API parameters.
+func (r *firewallIPSetResource) entryParams(entry firewallIPSetResourceEntryModel) map[string]any {
+	params := map[string]any{}
+	if !entry.Comment.IsNull() {
+		params["comment"] = entry.Comment.ValueString()
+	}
+	if !entry.NoMatch.IsNull() {
+		params["nomatch"] = boolToInt(entry.NoMatch.ValueBool())
+	}
+	return params
+}
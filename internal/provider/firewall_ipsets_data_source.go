@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &firewallIPSetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &firewallIPSetsDataSource{}
+)
+
+func NewFirewallIPSetsDataSource() datasource.DataSource {
+	return &firewallIPSetsDataSource{}
+}
+
+type firewallIPSetsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type firewallIPSetsDataSourceModel struct {
+	Data   []firewallIPSetsDataSourceIPSetModel `tfsdk:"data"`
+	Filter *firewallScopeFilterModel            `tfsdk:"filter"`
+}
+
+type firewallIPSetsDataSourceIPSetModel struct {
+	Comment types.String                         `tfsdk:"comment"`
+	Entries []firewallIPSetsDataSourceEntryModel `tfsdk:"entries"`
+	Name    types.String                         `tfsdk:"name"`
+}
+
+type firewallIPSetsDataSourceEntryModel struct {
+	CIDR    types.String `tfsdk:"cidr"`
+	Comment types.String `tfsdk:"comment"`
+	NoMatch types.Bool   `tfsdk:"nomatch"`
+}
+
+func (d *firewallIPSetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *firewallIPSetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_firewall_ipsets"
+}
+
+func (d *firewallIPSetsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Computed: true,
+						},
+						"entries": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"cidr": schema.StringAttribute{
+										Computed: true,
+									},
+									"comment": schema.StringAttribute{
+										Computed: true,
+									},
+									"nomatch": schema.BoolAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": firewallScopeFilterSchema(),
+		},
+	}
+}
+
+func (d *firewallIPSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config firewallIPSetsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the IPSets
+	basePath := d.providerData.firewallBasePath(config.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var ipsets []firewallIPSet
+	if err := d.providerData.client.Get(ctx, basePath+"/ipset", &ipsets); err != nil {
+		tflog.Error(ctx, "failed to retrieve firewall IPSets", map[string]any{
+			"path":  basePath,
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Firewall IPSets",
+			fmt.Sprintf("Failed to retrieve the firewall IPSets:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := firewallIPSetsDataSourceModel{
+		Data:   []firewallIPSetsDataSourceIPSetModel{},
+		Filter: config.Filter,
+	}
+	for _, ipset := range ipsets {
+		entries, err := d.providerData.firewallIPSetEntries(ctx, basePath, ipset.Name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve Firewall IPSet",
+				fmt.Sprintf("Failed to retrieve the entries of the firewall IPSet '%s':\n\t%s", ipset.Name,
+					err.Error()),
+			)
+			return
+		}
+		model := firewallIPSetsDataSourceIPSetModel{
+			Comment: types.StringValue(ipset.Comment),
+			Entries: []firewallIPSetsDataSourceEntryModel{},
+			Name:    types.StringValue(ipset.Name),
+		}
+		for _, entry := range entries {
+			model.Entries = append(model.Entries, firewallIPSetsDataSourceEntryModel{
+				CIDR:    configString(entry, "cidr"),
+				Comment: configString(entry, "comment"),
+				NoMatch: configBool(entry, "nomatch", types.BoolValue(false)),
+			})
+		}
+		state.Data = append(state.Data, model)
+	}
+	sort.Slice(state.Data, func(i, j int) bool {
+		return state.Data[i].Name.ValueString() < state.Data[j].Name.ValueString()
+	})
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &forecastUsageFunction{}
+)
+
+func NewForecastUsageFunction() function.Function {
+	return &forecastUsageFunction{}
+}
+
+type forecastUsageFunction struct{}
+
+// usagePoint is a single RRD data point. The value may be null since RRD data contains gaps.
+type usagePoint struct {
+	Time  types.Float64 `tfsdk:"time"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+// usageForecast is the linear projection of a series of usage points.
+type usageForecast struct {
+	ProjectedTime  float64 `tfsdk:"projected_time"`
+	ProjectedValue float64 `tfsdk:"projected_value"`
+	Slope          float64 `tfsdk:"slope"`
+}
+
+func (f *forecastUsageFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "forecast_usage"
+}
+
+func (f *forecastUsageFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Projects usage linearly into the future",
+		Description: "Fits a straight line through the given RRD points using ordinary least squares and " +
+			"extends it by the horizon (in seconds) past the last point. Points with a null value are skipped " +
+			"and at least two points at different times are required. Returns an object with the slope (change " +
+			"in value per second), the projected_time and the projected_value.",
+		MarkdownDescription: "Fits a straight line through the given RRD points using ordinary least squares and " +
+			"extends it by the `horizon` (in seconds) past the last point. Points with a null `value` are " +
+			"skipped and at least two points at different times are required. Returns an object with the " +
+			"`slope` (change in value per second), the `projected_time` and the `projected_value`.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "points",
+				Description: "Data points with a time (Unix timestamp) and a value",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"time":  types.Float64Type,
+						"value": types.Float64Type,
+					},
+				},
+			},
+			function.Float64Parameter{
+				Name:        "horizon",
+				Description: "Number of seconds past the last point to project the value to",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"projected_time":  types.Float64Type,
+				"projected_value": types.Float64Type,
+				"slope":           types.Float64Type,
+			},
+		},
+	}
+}
+
+func (f *forecastUsageFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var points []usagePoint
+	var horizon types.Float64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &points, &horizon))
+	if resp.Error != nil {
+		return
+	}
+	if horizon.ValueFloat64() < 0 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("the horizon must not be negative: %g",
+			horizon.ValueFloat64()))
+		return
+	}
+
+	forecast, err := forecastUsage(points, horizon.ValueFloat64())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, forecast))
+}
+
+// forecastUsage fits the line value = intercept + slope * time through the points with ordinary least squares:
+//
+//	slope     = Σ(t - mean(t)) * (v - mean(v)) / Σ(t - mean(t))²
+//	intercept = mean(v) - slope * mean(t)
+//
+// and evaluates it at the time of the last point plus the horizon. Times are taken relative to the first point
+// to keep the sums small enough to avoid losing precision with Unix timestamps.
+func forecastUsage(points []usagePoint, horizon float64) (usageForecast, error) {
+	var times, values []float64
+	lastTime := 0.0
+	for _, point := range points {
+		if point.Value.IsNull() || point.Time.IsNull() {
+			continue
+		}
+		if len(times) == 0 || point.Time.ValueFloat64() > lastTime {
+			lastTime = point.Time.ValueFloat64()
+		}
+		times = append(times, point.Time.ValueFloat64())
+		values = append(values, point.Value.ValueFloat64())
+	}
+	if len(times) < 2 {
+		return usageForecast{}, fmt.Errorf("at least two points with a value are required, got %d", len(times))
+	}
+
+	origin := times[0]
+	var meanTime, meanValue float64
+	for i := range times {
+		meanTime += times[i] - origin
+		meanValue += values[i]
+	}
+	meanTime /= float64(len(times))
+	meanValue /= float64(len(values))
+	var covariance, variance float64
+	for i := range times {
+		deltaTime := times[i] - origin - meanTime
+		covariance += deltaTime * (values[i] - meanValue)
+		variance += deltaTime * deltaTime
+	}
+	if variance == 0 {
+		return usageForecast{}, fmt.Errorf("the points must not all have the same time")
+	}
+
+	slope := covariance / variance
+	intercept := meanValue - slope*meanTime
+	projectedTime := lastTime + horizon
+	return usageForecast{
+		ProjectedTime:  projectedTime,
+		ProjectedValue: intercept + slope*(projectedTime-origin),
+		Slope:          slope,
+	}, nil
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &freeVMIDsDataSource{}
+	_ datasource.DataSourceWithConfigure = &freeVMIDsDataSource{}
+)
+
+func NewFreeVMIDsDataSource() datasource.DataSource {
+	return &freeVMIDsDataSource{}
+}
+
+type freeVMIDsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type freeVMIDsDataSourceModel struct {
+	Data   []types.Int32                   `tfsdk:"data"`
+	Filter *freeVMIDsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type freeVMIDsDataSourceFilterModel struct {
+	Count types.Int64 `tfsdk:"count"`
+	End   types.Int32 `tfsdk:"end"`
+	Start types.Int32 `tfsdk:"start"`
+}
+
+func (d *freeVMIDsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *freeVMIDsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_free_vmids"
+}
+
+func (d *freeVMIDsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Finds the lowest VM IDs in a range which are not used by any VM or container in the " +
+			"cluster. The IDs are not reserved, so they may be taken by someone else before they are used.",
+		MarkdownDescription: "Finds the lowest VM IDs in a range which are not used by any VM or container in the " +
+			"cluster. The IDs are not reserved, so they may be taken by someone else before they are used.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListAttribute{
+				Description:         "Free VM IDs in ascending order",
+				MarkdownDescription: "Free VM IDs in ascending order",
+				Computed:            true,
+				ElementType:         types.Int32Type,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"count": schema.Int64Attribute{
+						Description:         "Number of free VM IDs to return (default: 1)",
+						MarkdownDescription: "Number of free VM IDs to return (default: `1`)",
+						Optional:            true,
+					},
+					"end": schema.Int32Attribute{
+						Description:         fmt.Sprintf("Last VM ID of the range (default: %d)", maxVMID),
+						MarkdownDescription: fmt.Sprintf("Last VM ID of the range (default: `%d`)", maxVMID),
+						Optional:            true,
+					},
+					"start": schema.Int32Attribute{
+						Description:         fmt.Sprintf("First VM ID of the range (default: %d)", minVMID),
+						MarkdownDescription: fmt.Sprintf("First VM ID of the range (default: `%d`)", minVMID),
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *freeVMIDsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config freeVMIDsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure the range is valid
+	start, end, count := int64(minVMID), int64(maxVMID), int64(1)
+	if config.Filter != nil {
+		if !config.Filter.Start.IsNull() {
+			start = int64(config.Filter.Start.ValueInt32())
+		}
+		if !config.Filter.End.IsNull() {
+			end = int64(config.Filter.End.ValueInt32())
+		}
+		if !config.Filter.Count.IsNull() {
+			count = config.Filter.Count.ValueInt64()
+		}
+	}
+	if start < minVMID || end > maxVMID || start > end {
+		resp.Diagnostics.AddError(
+			"Invalid Filter VM ID Range",
+			fmt.Sprintf("The VM ID range %d-%d is invalid; it must lie within %d-%d and start must not be "+
+				"greater than end.", start, end, minVMID, maxVMID),
+		)
+		return
+	}
+	if count < 1 || count > end-start+1 {
+		resp.Diagnostics.AddError(
+			"Invalid Filter Count",
+			fmt.Sprintf("The filter count must be between 1 and the size of the range (%d): %d.", end-start+1,
+				count),
+		)
+		return
+	}
+
+	// query for the VM IDs in use
+	resources, err := d.providerData.clusterVMResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+	used := map[int64]bool{}
+	for _, res := range resources {
+		used[int64(res.VMID)] = true
+	}
+
+	// collect the lowest free IDs
+	state := freeVMIDsDataSourceModel{
+		Data:   []types.Int32{},
+		Filter: config.Filter,
+	}
+	for vmID := start; vmID <= end && int64(len(state.Data)) < count; vmID++ {
+		if !used[vmID] {
+			state.Data = append(state.Data, types.Int32Value(int32(vmID)))
+		}
+	}
+	if int64(len(state.Data)) < count {
+		resp.Diagnostics.AddError(
+			"Not Enough Free VM IDs",
+			fmt.Sprintf("Only %d of the %d requested VM IDs are free in the range %d-%d.", len(state.Data), count,
+				start, end),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	guestTypeLXC  = "lxc"
+	guestTypeQEMU = "qemu"
+)
+
+// guestStatus is the subset of the response from the current status endpoint shared by VMs and containers.
+type guestStatus struct {
+	BalloonInfo *struct {
+		Actual uint64 `json:"actual"`
+	} `json:"ballooninfo"`
+	CPU  float64 `json:"cpu"`
+	CPUs int64   `json:"cpus"`
+	Disk uint64  `json:"disk"`
+	HA   struct {
+		Managed int `json:"managed"`
+	} `json:"ha"`
+	MaxDisk uint64 `json:"maxdisk"`
+	MaxMem  uint64 `json:"maxmem"`
+	MaxSwap uint64 `json:"maxswap"`
+	Mem     uint64 `json:"mem"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Swap    uint64 `json:"swap"`
+	Uptime  uint64 `json:"uptime"`
+}
+
+// guestStatusModel holds the status attributes shared by the VM and container status data sources.
+type guestStatusModel struct {
+	CPU       types.Float64 `tfsdk:"cpu"`
+	Disk      types.Int64   `tfsdk:"disk"`
+	HAManaged types.Bool    `tfsdk:"ha_managed"`
+	MaxMem    types.Int64   `tfsdk:"maxmem"`
+	Mem       types.Int64   `tfsdk:"mem"`
+	Status    types.String  `tfsdk:"status"`
+	Uptime    types.Int64   `tfsdk:"uptime"`
+}
+
+// currentGuestStatus retrieves the current status of the VM or container of the given type ('qemu' or 'lxc').
+func (p *proxmoxveProviderData) currentGuestStatus(ctx context.Context, nodeName, guestType string,
+	vmID int) (*guestStatus, error) {
+
+	var status guestStatus
+	err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/%s/%d/status/current",
+		url.PathEscape(nodeName), guestType, vmID), &status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// model converts the status into the shared status attributes.
+func (s *guestStatus) model() guestStatusModel {
+	return guestStatusModel{
+		CPU:       types.Float64Value(s.CPU),
+		Disk:      uint64Value(s.Disk),
+		HAManaged: types.BoolValue(s.HA.Managed != 0),
+		MaxMem:    uint64Value(s.MaxMem),
+		Mem:       uint64Value(s.Mem),
+		Status:    types.StringValue(s.Status),
+		Uptime:    uint64Value(s.Uptime),
+	}
+}
+
+// guestStatusSchemaAttributes returns the schema attributes for a guestStatusModel.
+func guestStatusSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cpu": schema.Float64Attribute{
+			Description:         "Current CPU usage as a fraction of the allocated CPUs",
+			MarkdownDescription: "Current CPU usage as a fraction of the allocated CPUs",
+			Computed:            true,
+		},
+		"disk": schema.Int64Attribute{
+			Description:         "Used disk space in bytes",
+			MarkdownDescription: "Used disk space in bytes",
+			Computed:            true,
+		},
+		"ha_managed": schema.BoolAttribute{
+			Description:         "Whether the guest is managed by the HA stack",
+			MarkdownDescription: "Whether the guest is managed by the HA stack",
+			Computed:            true,
+		},
+		"maxmem": schema.Int64Attribute{
+			Description:         "Configured memory in bytes",
+			MarkdownDescription: "Configured memory in bytes",
+			Computed:            true,
+		},
+		"mem": schema.Int64Attribute{
+			Description:         "Used memory in bytes",
+			MarkdownDescription: "Used memory in bytes",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			Computed: true,
+		},
+		"uptime": schema.Int64Attribute{
+			Description:         "Uptime in seconds",
+			MarkdownDescription: "Uptime in seconds",
+			Computed:            true,
+		},
+	}
+}
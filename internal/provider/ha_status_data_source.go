@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	haStatusTypeMaster  = "master"
+	haStatusTypeQuorum  = "quorum"
+	haStatusTypeService = "service"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &haStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &haStatusDataSource{}
+)
+
+func NewHAStatusDataSource() datasource.DataSource {
+	return &haStatusDataSource{}
+}
+
+type haStatusDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type haStatusDataSourceModel struct {
+	Configured  types.Bool                        `tfsdk:"configured"`
+	ManagerNode types.String                      `tfsdk:"manager_node"`
+	Quorate     types.Bool                        `tfsdk:"quorate"`
+	Resources   []haStatusDataSourceResourceModel `tfsdk:"resources"`
+}
+
+type haStatusDataSourceResourceModel struct {
+	CRMState     types.String `tfsdk:"crm_state"`
+	Node         types.String `tfsdk:"node"`
+	RequestState types.String `tfsdk:"request_state"`
+	SID          types.String `tfsdk:"sid"`
+	State        types.String `tfsdk:"state"`
+	Status       types.String `tfsdk:"status"`
+}
+
+// haStatusEntry is a single entry of the current HA status, which describes either the quorum, the manager
+// (master), the local resource manager of a node or an HA resource (service) depending on its type.
+type haStatusEntry struct {
+	CRMState     string `json:"crm_state"`
+	Node         string `json:"node"`
+	Quorate      int    `json:"quorate"`
+	RequestState string `json:"request_state"`
+	SID          string `json:"sid"`
+	State        string `json:"state"`
+	Status       string `json:"status"`
+	Type         string `json:"type"`
+}
+
+func (d *haStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *haStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_ha_status"
+}
+
+func (d *haStatusDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Live status of the high availability (HA) manager and the HA resources, sorted by resource " +
+			"ID. When HA is not configured there is no manager node and the list of resources is empty.",
+		MarkdownDescription: "Live status of the high availability (HA) manager and the HA resources, sorted by " +
+			"resource ID. When HA is not configured there is no `manager_node` and the list of `resources` is " +
+			"empty.",
+		Attributes: map[string]schema.Attribute{
+			"configured": schema.BoolAttribute{
+				Description:         "Whether or not an HA manager is active in the cluster",
+				MarkdownDescription: "Whether or not an HA manager is active in the cluster",
+				Computed:            true,
+			},
+			"manager_node": schema.StringAttribute{
+				Description:         "Node running the active HA manager; null when HA is not configured",
+				MarkdownDescription: "Node running the active HA manager; null when HA is not configured",
+				Computed:            true,
+			},
+			"quorate": schema.BoolAttribute{
+				Description:         "Whether or not the cluster has quorum",
+				MarkdownDescription: "Whether or not the cluster has quorum",
+				Computed:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"crm_state": schema.StringAttribute{
+							Description:         "State of the resource as seen by the cluster resource manager",
+							MarkdownDescription: "State of the resource as seen by the cluster resource manager",
+							Computed:            true,
+						},
+						"node": schema.StringAttribute{
+							Description:         "Node the resource is currently assigned to",
+							MarkdownDescription: "Node the resource is currently assigned to",
+							Computed:            true,
+						},
+						"request_state": schema.StringAttribute{
+							Description:         "State requested for the resource (eg: started or stopped)",
+							MarkdownDescription: "State requested for the resource (eg: `started` or `stopped`)",
+							Computed:            true,
+						},
+						"sid": schema.StringAttribute{
+							Description:         "ID of the resource (eg: vm:100)",
+							MarkdownDescription: "ID of the resource (eg: `vm:100`)",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "Current state of the resource (eg: started, fence or error)",
+							MarkdownDescription: "Current state of the resource (eg: `started`, `fence` or `error`)",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "Human readable summary of the resource's status",
+							MarkdownDescription: "Human readable summary of the resource's status",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *haStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// query for the HA status
+	var entries []haStatusEntry
+	if err := d.providerData.client.Get(ctx, "/cluster/ha/status/current", &entries); err != nil {
+		tflog.Error(ctx, "failed to retrieve HA status", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve HA Status",
+			fmt.Sprintf("Failed to retrieve the HA status:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model; the node status entries of the local resource managers are not included
+	state := haStatusDataSourceModel{
+		Configured:  types.BoolValue(false),
+		ManagerNode: types.StringNull(),
+		Quorate:     types.BoolValue(false),
+		Resources:   []haStatusDataSourceResourceModel{},
+	}
+	slices.SortFunc(entries, func(a, b haStatusEntry) int {
+		return cmp.Compare(a.SID, b.SID)
+	})
+	for _, entry := range entries {
+		switch entry.Type {
+		case haStatusTypeQuorum:
+			state.Quorate = types.BoolValue(entry.Quorate != 0)
+		case haStatusTypeMaster:
+			if entry.Node != "" {
+				state.Configured = types.BoolValue(true)
+				state.ManagerNode = types.StringValue(entry.Node)
+			}
+		case haStatusTypeService:
+			state.Resources = append(state.Resources, haStatusDataSourceResourceModel{
+				CRMState:     haStatusString(entry.CRMState),
+				Node:         haStatusString(entry.Node),
+				RequestState: haStatusString(entry.RequestState),
+				SID:          types.StringValue(entry.SID),
+				State:        haStatusString(entry.State),
+				Status:       haStatusString(entry.Status),
+			})
+		}
+	}
+
+	// set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// haStatusString returns the given HA status value, or null if the API omitted it.
+func haStatusString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
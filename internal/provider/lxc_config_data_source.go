@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lxcConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &lxcConfigDataSource{}
+)
+
+func NewLXCConfigDataSource() datasource.DataSource {
+	return &lxcConfigDataSource{}
+}
+
+type lxcConfigDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type lxcConfigDataSourceModel struct {
+	Data   *lxcConfigDataSourceDataModel   `tfsdk:"data"`
+	Filter *lxcConfigDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type lxcConfigDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type lxcConfigDataSourceDataModel struct {
+	Arch         types.String                      `tfsdk:"arch"`
+	Cores        types.Int64                       `tfsdk:"cores"`
+	Description  types.String                      `tfsdk:"description"`
+	Features     *lxcConfigDataSourceFeaturesModel `tfsdk:"features"`
+	Hostname     types.String                      `tfsdk:"hostname"`
+	Memory       types.Int64                       `tfsdk:"memory"`
+	Node         types.String                      `tfsdk:"node"`
+	OnBoot       types.Bool                        `tfsdk:"onboot"`
+	OSType       types.String                      `tfsdk:"os_type"`
+	Protection   types.Bool                        `tfsdk:"protection"`
+	Startup      *startupModel                     `tfsdk:"startup"`
+	Swap         types.Int64                       `tfsdk:"swap"`
+	Tags         []types.String                    `tfsdk:"tags"`
+	Unprivileged types.Bool                        `tfsdk:"unprivileged"`
+	VMID         types.Int32                       `tfsdk:"vm_id"`
+}
+
+type lxcConfigDataSourceFeaturesModel struct {
+	ForceRWSys types.Bool   `tfsdk:"force_rw_sys"`
+	Fuse       types.Bool   `tfsdk:"fuse"`
+	KeyCtl     types.Bool   `tfsdk:"keyctl"`
+	MkNod      types.Bool   `tfsdk:"mknod"`
+	Mount      types.List   `tfsdk:"mount"`
+	Nesting    types.Bool   `tfsdk:"nesting"`
+	RawConfig  types.String `tfsdk:"raw_config"`
+}
+
+func (d *lxcConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *lxcConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_lxc_config"
+}
+
+func (d *lxcConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"arch": schema.StringAttribute{
+						Computed: true,
+					},
+					"cores": schema.Int64Attribute{
+						Computed: true,
+					},
+					"features": schema.SingleNestedAttribute{
+						Description:         "Advanced features enabled for the container",
+						MarkdownDescription: "Advanced features enabled for the container",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"force_rw_sys": schema.BoolAttribute{
+								Computed: true,
+							},
+							"fuse": schema.BoolAttribute{
+								Computed: true,
+							},
+							"keyctl": schema.BoolAttribute{
+								Computed: true,
+							},
+							"mknod": schema.BoolAttribute{
+								Computed: true,
+							},
+							"mount": schema.ListAttribute{
+								Description:         "File system types allowed to be mounted",
+								MarkdownDescription: "File system types allowed to be mounted",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"nesting": schema.BoolAttribute{
+								Computed: true,
+							},
+							"raw_config": schema.StringAttribute{
+								Description:         "Raw features string; null when no features are enabled",
+								MarkdownDescription: "Raw `features` string; null when no features are enabled",
+								Computed:            true,
+							},
+						},
+					},
+					"description": schema.StringAttribute{
+						Description:         "Notes shown in the container's summary; null when unset",
+						MarkdownDescription: "Notes shown in the container's summary; null when unset",
+						Computed:            true,
+					},
+					"hostname": schema.StringAttribute{
+						Computed: true,
+					},
+					"memory": schema.Int64Attribute{
+						Description:         "Memory in MiB",
+						MarkdownDescription: "Memory in MiB",
+						Computed:            true,
+					},
+					"node": schema.StringAttribute{
+						Computed: true,
+					},
+					"onboot": schema.BoolAttribute{
+						Description:         "Whether or not the container is started when the node boots",
+						MarkdownDescription: "Whether or not the container is started when the node boots",
+						Computed:            true,
+					},
+					"os_type": schema.StringAttribute{
+						Computed: true,
+					},
+					"protection": schema.BoolAttribute{
+						Description:         "Whether the container is protected from removal",
+						MarkdownDescription: "Whether the container is protected from removal",
+						Computed:            true,
+					},
+					"startup": startupDataSourceSchemaAttribute(),
+					"swap": schema.Int64Attribute{
+						Description:         "Swap in MiB",
+						MarkdownDescription: "Swap in MiB",
+						Computed:            true,
+					},
+					"tags": schema.ListAttribute{
+						Description:         "Normalized tags of the container, lower-cased and sorted",
+						MarkdownDescription: "Normalized tags of the container, lower-cased and sorted",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"unprivileged": schema.BoolAttribute{
+						Computed: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Computed: true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the container; defaults to the provider's " +
+							"default_node when omitted",
+						MarkdownDescription: "Name of the node hosting the container; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *lxcConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config lxcConfigDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a container ID and node are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the container configuration.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the container "+
+				"configuration or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the container configuration.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+
+	// query for the configuration
+	rawConfig, err := d.providerData.rawContainerConfig(ctx, nodeName, vmID)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve container configuration", map[string]any{
+			"node_name": nodeName,
+			"vm_id":     vmID,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Container Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the container with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := lxcConfigDataSourceModel{
+		Data: &lxcConfigDataSourceDataModel{
+			Arch:         configString(rawConfig, "arch"),
+			Cores:        configInt64(rawConfig, "cores"),
+			Features:     d.parseFeatures(configString(rawConfig, "features").ValueString()),
+			Description:  configString(rawConfig, "description"),
+			Hostname:     configString(rawConfig, "hostname"),
+			Memory:       configInt64(rawConfig, "memory"),
+			Node:         types.StringValue(nodeName),
+			OnBoot:       configBool(rawConfig, "onboot", types.BoolValue(false)),
+			OSType:       configString(rawConfig, "ostype"),
+			Protection:   configBool(rawConfig, "protection", types.BoolValue(false)),
+			Startup:      parseStartup(configString(rawConfig, "startup").ValueString()),
+			Swap:         configInt64(rawConfig, "swap"),
+			Tags:         []types.String{},
+			Unprivileged: configBool(rawConfig, "unprivileged", types.BoolValue(false)),
+			VMID:         types.Int32Value(int32(vmID)),
+		},
+		Filter: config.Filter,
+	}
+	for _, tag := range normalizeTags(configString(rawConfig, "tags").ValueString()) {
+		state.Data.Tags = append(state.Data.Tags, types.StringValue(tag))
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// parseFeatures parses the 'features' option of a container (eg: nesting=1,keyctl=1,mount=nfs;cifs). Features
+// which are not present are disabled.
+func (d *lxcConfigDataSource) parseFeatures(config string) *lxcConfigDataSourceFeaturesModel {
+	properties := parsePropertyString(config, "")
+	enabled := func(key string) types.Bool {
+		return types.BoolValue(properties[key] == "1")
+	}
+
+	mounts := []attr.Value{}
+	for _, fsType := range strings.Split(properties["mount"], ";") {
+		if fsType = strings.TrimSpace(fsType); fsType != "" {
+			mounts = append(mounts, types.StringValue(fsType))
+		}
+	}
+
+	features := &lxcConfigDataSourceFeaturesModel{
+		ForceRWSys: enabled("force_rw_sys"),
+		Fuse:       enabled("fuse"),
+		KeyCtl:     enabled("keyctl"),
+		MkNod:      enabled("mknod"),
+		Mount:      types.ListValueMust(types.StringType, mounts),
+		Nesting:    enabled("nesting"),
+		RawConfig:  types.StringNull(),
+	}
+	if config != "" {
+		features.RawConfig = types.StringValue(config)
+	}
+	return features
+}
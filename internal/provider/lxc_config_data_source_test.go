@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// readTestLXCConfig reads the configuration of the container 200 on the node pve1 from a test server returning
+// the given configuration.
+func readTestLXCConfig(t *testing.T, lxcConfig map[string]any) (*lxcConfigDataSourceDataModel, diag.Diagnostics) {
+	t.Helper()
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api2/json/nodes/pve1/lxc/200/config" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		writeTestData(t, w, lxcConfig)
+	})
+	config := lxcConfigDataSourceModel{
+		Filter: &lxcConfigDataSourceFilterModel{NodeName: types.StringValue("pve1"), VMID: types.Int32Value(200)},
+	}
+	var state lxcConfigDataSourceModel
+	diags := readTestDataSource(t, &lxcConfigDataSource{providerData: data}, config, &state)
+	return state.Data, diags
+}
+
+func TestLXCConfigParseFeatures(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   lxcConfigDataSourceFeaturesModel
+	}{
+		{
+			name:   "multiple flags",
+			config: "nesting=1,keyctl=1,fuse=1",
+			want: lxcConfigDataSourceFeaturesModel{
+				ForceRWSys: types.BoolValue(false),
+				Fuse:       types.BoolValue(true),
+				KeyCtl:     types.BoolValue(true),
+				MkNod:      types.BoolValue(false),
+				Mount:      types.ListValueMust(types.StringType, []attr.Value{}),
+				Nesting:    types.BoolValue(true),
+				RawConfig:  types.StringValue("nesting=1,keyctl=1,fuse=1"),
+			},
+		},
+		{
+			name:   "disabled flags and mounts",
+			config: "nesting=0, mount=nfs;cifs ,mknod=1",
+			want: lxcConfigDataSourceFeaturesModel{
+				ForceRWSys: types.BoolValue(false),
+				Fuse:       types.BoolValue(false),
+				KeyCtl:     types.BoolValue(false),
+				MkNod:      types.BoolValue(true),
+				Mount: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("nfs"),
+					types.StringValue("cifs"),
+				}),
+				Nesting:   types.BoolValue(false),
+				RawConfig: types.StringValue("nesting=0, mount=nfs;cifs ,mknod=1"),
+			},
+		},
+		{
+			name: "no features",
+			want: lxcConfigDataSourceFeaturesModel{
+				ForceRWSys: types.BoolValue(false),
+				Fuse:       types.BoolValue(false),
+				KeyCtl:     types.BoolValue(false),
+				MkNod:      types.BoolValue(false),
+				Mount:      types.ListValueMust(types.StringType, []attr.Value{}),
+				Nesting:    types.BoolValue(false),
+				RawConfig:  types.StringNull(),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := (&lxcConfigDataSource{}).parseFeatures(test.config)
+			if !reflect.DeepEqual(*got, test.want) {
+				t.Errorf("parseFeatures(%q) = %+v, want %+v", test.config, *got, test.want)
+			}
+		})
+	}
+}
+
+func TestLXCConfigUnprivileged(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   types.Bool
+	}{
+		{name: "unprivileged", config: map[string]any{"unprivileged": 1}, want: types.BoolValue(true)},
+		{name: "privileged", config: map[string]any{"unprivileged": 0}, want: types.BoolValue(false)},
+		{name: "unset", config: map[string]any{}, want: types.BoolValue(false)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, diags := readTestLXCConfig(t, test.config)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if data.Unprivileged != test.want {
+				t.Errorf("unprivileged = %v, want %v", data.Unprivileged, test.want)
+			}
+			if data.Protection != types.BoolValue(false) {
+				t.Errorf("protection = %v, want false", data.Protection)
+			}
+		})
+	}
+}
+
+func TestLXCConfigMetadata(t *testing.T) {
+	data, diags := readTestLXCConfig(t, map[string]any{
+		"description": "DNS resolver\n",
+		"onboot":      1,
+		"protection":  1,
+		"startup":     "order=2,up=15",
+		"tags":        "Prod;dns,prod",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []string{"dns", "prod"}; !reflect.DeepEqual(stringValues(data.Tags), want) {
+		t.Errorf("tags = %v, want %v", data.Tags, want)
+	}
+	if data.OnBoot != types.BoolValue(true) || data.Protection != types.BoolValue(true) {
+		t.Errorf("onboot = %v, protection = %v, want both true", data.OnBoot, data.Protection)
+	}
+	wantStartup := &startupModel{Down: types.Int64Null(), Order: types.Int64Value(2), Up: types.Int64Value(15)}
+	if !reflect.DeepEqual(data.Startup, wantStartup) {
+		t.Errorf("startup = %+v, want %+v", data.Startup, wantStartup)
+	}
+	if want := types.StringValue("DNS resolver\n"); data.Description != want {
+		t.Errorf("description = %v, want %v", data.Description, want)
+	}
+}
+
+func TestLXCConfigMetadataUnset(t *testing.T) {
+	data, diags := readTestLXCConfig(t, map[string]any{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(data.Tags) != 0 || data.OnBoot != types.BoolValue(false) || data.Startup != nil ||
+		!data.Description.IsNull() {
+
+		t.Errorf("tags = %v, onboot = %v, startup = %+v, description = %v, want no metadata", data.Tags,
+			data.OnBoot, data.Startup, data.Description)
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lxcStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &lxcStatusDataSource{}
+)
+
+func NewLXCStatusDataSource() datasource.DataSource {
+	return &lxcStatusDataSource{}
+}
+
+type lxcStatusDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type lxcStatusDataSourceModel struct {
+	Data   *lxcStatusDataSourceDataModel   `tfsdk:"data"`
+	Filter *lxcStatusDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type lxcStatusDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type lxcStatusDataSourceDataModel struct {
+	guestStatusModel
+	Swap types.Int64 `tfsdk:"swap"`
+}
+
+func (d *lxcStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *lxcStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_lxc_status"
+}
+
+func (d *lxcStatusDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	dataAttributes := map[string]schema.Attribute{
+		"swap": schema.Int64Attribute{
+			Description:         "Used swap in bytes",
+			MarkdownDescription: "Used swap in bytes",
+			Computed:            true,
+		},
+	}
+	maps.Copy(dataAttributes, guestStatusSchemaAttributes())
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed:   true,
+				Attributes: dataAttributes,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the container; defaults to the provider's " +
+							"default_node when omitted",
+						MarkdownDescription: "Name of the node hosting the container; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *lxcStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config lxcStatusDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a container ID and node are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the container status.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the container "+
+				"status or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the container status.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+
+	// query for the status
+	status, err := d.providerData.currentGuestStatus(ctx, nodeName, guestTypeLXC, vmID)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve container status", map[string]any{
+			"node_name": nodeName,
+			"vm_id":     vmID,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Container Status",
+			fmt.Sprintf("Failed to retrieve the status of the container with the ID '%d':\n\t%s", vmID,
+				err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := lxcStatusDataSourceModel{
+		Data: &lxcStatusDataSourceDataModel{
+			guestStatusModel: status.model(),
+			Swap:             uint64Value(status.Swap),
+		},
+		Filter: config.Filter,
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &macLookupDataSource{}
+	_ datasource.DataSourceWithConfigure = &macLookupDataSource{}
+)
+
+func NewMACLookupDataSource() datasource.DataSource {
+	return &macLookupDataSource{}
+}
+
+type macLookupDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type macLookupDataSourceModel struct {
+	Data   *macLookupDataSourceDataModel   `tfsdk:"data"`
+	Filter *macLookupDataSourceFilterModel `tfsdk:"filter"`
+	Found  types.Bool                      `tfsdk:"found"`
+}
+
+type macLookupDataSourceFilterModel struct {
+	HardwareAddress types.String `tfsdk:"mac_addr"`
+}
+
+type macLookupDataSourceDataModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Node      types.String `tfsdk:"node"`
+	VMID      types.Int32  `tfsdk:"vm_id"`
+}
+
+func (d *macLookupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *macLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_mac_lookup"
+}
+
+func (d *macLookupDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Finds the VM and network interface which own a MAC address by scanning the network " +
+			"interfaces of every VM in the cluster.",
+		MarkdownDescription: "Finds the VM and network interface which own a MAC address by scanning the network " +
+			"interfaces of every VM in the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Description:         "The owner of the MAC address; null if no VM uses it",
+				MarkdownDescription: "The owner of the MAC address; null if no VM uses it",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"interface": schema.StringAttribute{
+						Description:         "Name of the network interface (eg: net0)",
+						MarkdownDescription: "Name of the network interface (eg: `net0`)",
+						Computed:            true,
+					},
+					"node": schema.StringAttribute{
+						Computed: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Computed: true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"mac_addr": schema.StringAttribute{
+						Description: "MAC address to look up in any common notation (eg: bc:24:11:00:00:01 or " +
+							"BC-24-11-00-00-01)",
+						MarkdownDescription: "MAC address to look up in any common notation (eg: `bc:24:11:00:00:01` " +
+							"or `BC-24-11-00-00-01`)",
+						Required: true,
+					},
+				},
+			},
+			"found": schema.BoolAttribute{
+				Description:         "Whether or not a VM uses the MAC address",
+				MarkdownDescription: "Whether or not a VM uses the MAC address",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *macLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config macLookupDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a valid MAC address is specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to look up a MAC address.",
+		)
+		return
+	}
+	hardwareAddr, err := parseMACAddress(config.Filter.HardwareAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Filter MAC Address",
+			fmt.Sprintf("The MAC address is not valid: %s.", err.Error()),
+		)
+		return
+	}
+	mac := strings.ToUpper(hardwareAddr.String())
+
+	// scan the network interfaces of every VM; they are sorted by ID so that the result is stable should the
+	// same MAC address be used more than once
+	resources, err := d.providerData.clusterVMResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+	slices.SortFunc(resources, func(a, b *proxmox.ClusterResource) int {
+		return cmp.Compare(a.VMID, b.VMID)
+	})
+	state := macLookupDataSourceModel{
+		Filter: config.Filter,
+		Found:  types.BoolValue(false),
+	}
+	for _, res := range resources {
+		if res.Type != guestTypeQEMU {
+			continue
+		}
+		vmID := int(res.VMID)
+		rawConfig, err := d.providerData.rawVMConfig(ctx, res.Node, vmID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve VM Config",
+				fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+					vmID, err.Error()),
+			)
+			return
+		}
+		if name := macOwnerInterface(rawConfig, mac); name != "" {
+			tflog.Info(ctx, "located MAC address", map[string]any{"mac_addr": mac, "vm_id": vmID, "interface": name})
+			state.Found = types.BoolValue(true)
+			state.Data = &macLookupDataSourceDataModel{
+				Interface: types.StringValue(name),
+				Node:      types.StringValue(res.Node),
+				VMID:      types.Int32Value(int32(vmID)),
+			}
+			break
+		}
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// macOwnerInterface returns the name of the network interface (eg: net0) in the given raw VM configuration
+// which uses the given upper-case MAC address, or an empty string if none does.
+func macOwnerInterface(rawConfig map[string]any, mac string) string {
+	nets := map[string]string{}
+	for key := range rawConfig {
+		if prefix, index := splitConfigKey(key); prefix == "net" && index >= 0 {
+			nets[key] = configString(rawConfig, key).ValueString()
+		}
+	}
+	for _, name := range sortedConfigKeys(nets) {
+		if normalizeNetConfig(nets[name])["macaddr"] == mac {
+			return name
+		}
+	}
+	return ""
+}
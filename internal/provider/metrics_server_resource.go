@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &metricsServerResource{}
+	_ resource.ResourceWithConfigure      = &metricsServerResource{}
+	_ resource.ResourceWithImportState    = &metricsServerResource{}
+	_ resource.ResourceWithValidateConfig = &metricsServerResource{}
+)
+
+func NewMetricsServerResource() resource.Resource {
+	return &metricsServerResource{}
+}
+
+type metricsServerResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type metricsServerResourceModel struct {
+	Bucket       types.String `tfsdk:"bucket"`
+	Enable       types.Bool   `tfsdk:"enable"`
+	ID           types.String `tfsdk:"id"`
+	MTU          types.Int64  `tfsdk:"mtu"`
+	Organization types.String `tfsdk:"organization"`
+	Port         types.Int64  `tfsdk:"port"`
+	Server       types.String `tfsdk:"server"`
+	Token        types.String `tfsdk:"token"`
+	Type         types.String `tfsdk:"type"`
+}
+
+func (r *metricsServerResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *metricsServerResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_metrics_server"
+}
+
+func (r *metricsServerResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an external InfluxDB or Graphite metric server. Existing metric servers are " +
+			"imported using their ID.",
+		MarkdownDescription: "Manages an external InfluxDB or Graphite metric server. Existing metric servers " +
+			"are imported using their `id`.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description:         "InfluxDB bucket (only valid for InfluxDB servers)",
+				MarkdownDescription: "InfluxDB bucket (only valid for `influxdb` servers)",
+				Optional:            true,
+			},
+			"enable": schema.BoolAttribute{
+				Description:         "Whether metrics are sent to the server",
+				MarkdownDescription: "Whether metrics are sent to the server",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Description:         "Unique ID of the metric server; changing it forces a new server",
+				MarkdownDescription: "Unique ID of the metric server; changing it forces a new server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Description:         "MTU for UDP metric transmission",
+				MarkdownDescription: "MTU for UDP metric transmission",
+				Optional:            true,
+			},
+			"organization": schema.StringAttribute{
+				Description:         "InfluxDB organization (only valid for InfluxDB servers)",
+				MarkdownDescription: "InfluxDB organization (only valid for `influxdb` servers)",
+				Optional:            true,
+			},
+			"port": schema.Int64Attribute{
+				Required: true,
+			},
+			"server": schema.StringAttribute{
+				Description:         "Server address",
+				MarkdownDescription: "Server address",
+				Required:            true,
+			},
+			"token": schema.StringAttribute{
+				Description: "InfluxDB access token (only valid for InfluxDB servers); never read back from " +
+					"the server",
+				MarkdownDescription: "InfluxDB access token (only valid for `influxdb` servers); never read back " +
+					"from the server",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"type": schema.StringAttribute{
+				Description:         "Server type, either 'influxdb' or 'graphite'; changing it forces a new server",
+				MarkdownDescription: "Server type, either `influxdb` or `graphite`; changing it forces a new server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *metricsServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config metricsServerResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	switch config.Type.ValueString() {
+	case "influxdb":
+	case "graphite":
+		for name, value := range map[string]types.String{
+			"bucket":       config.Bucket,
+			"organization": config.Organization,
+			"token":        config.Token,
+		} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Unsupported Graphite Option",
+					fmt.Sprintf("The %s attribute is only supported by InfluxDB metric servers.", name),
+				)
+			}
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Unsupported Metric Server Type",
+			fmt.Sprintf("The metric server type '%s' is not supported; it must be either 'influxdb' or "+
+				"'graphite'.", config.Type.ValueString()),
+		)
+	}
+}
+
+func (r *metricsServerResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan metricsServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// create the metric server
+	id := plan.ID.ValueString()
+	params := r.params(plan, nil)
+	params["type"] = plan.Type.ValueString()
+	err := r.providerData.client.Post(ctx, fmt.Sprintf("/cluster/metrics/server/%s", url.PathEscape(id)), params,
+		nil)
+	if err != nil {
+		tflog.Error(ctx, "failed to create metric server", map[string]any{
+			"id":    id,
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Create Metric Server",
+			fmt.Sprintf("Failed to create the external metric server '%s':\n\t%s", id, err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *metricsServerResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state metricsServerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the metric server configuration
+	id := state.ID.ValueString()
+	var config map[string]any
+	err := r.providerData.client.Get(ctx, fmt.Sprintf("/cluster/metrics/server/%s", url.PathEscape(id)), &config)
+	if isNotFoundError(err) {
+		tflog.Warn(ctx, "metric server no longer exists", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Metric Server",
+			fmt.Sprintf("Failed to retrieve the external metric server '%s':\n\t%s", id, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model, keeping the token which is never returned; the server only reports the
+	// inverse 'disable' option so enable is left null unless it was configured or the server is disabled
+	state.Bucket = configString(config, "bucket")
+	disabled := configBool(config, "disable", types.BoolNull()).ValueBool()
+	if disabled || !state.Enable.IsNull() {
+		state.Enable = types.BoolValue(!disabled)
+	}
+	state.MTU = configInt64(config, "mtu")
+	state.Organization = configString(config, "organization")
+	state.Port = configInt64(config, "port")
+	state.Server = configString(config, "server")
+	state.Type = configString(config, "type")
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *metricsServerResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan and state
+	var plan, state metricsServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update the metric server
+	id := plan.ID.ValueString()
+	params := r.params(plan, &state)
+	err := r.providerData.client.Put(ctx, fmt.Sprintf("/cluster/metrics/server/%s", url.PathEscape(id)), params,
+		nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update Metric Server",
+			fmt.Sprintf("Failed to update the external metric server '%s':\n\t%s", id, err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *metricsServerResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state metricsServerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// delete the metric server
+	id := state.ID.ValueString()
+	err := r.providerData.client.Delete(ctx, fmt.Sprintf("/cluster/metrics/server/%s", url.PathEscape(id)), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Delete Metric Server",
+			fmt.Sprintf("Failed to delete the external metric server '%s':\n\t%s", id, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *metricsServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params converts the model into API parameters. When the prior state is given, any option that was previously
+// set but has been removed from the plan is added to the list of options to delete.
+func (r *metricsServerResource) params(plan metricsServerResourceModel,
+	state *metricsServerResourceModel) map[string]any {
+
+	params := map[string]any{}
+	deletes := []string{}
+	setString := func(key string, planned types.String, prior types.String) {
+		if !planned.IsNull() {
+			params[key] = planned.ValueString()
+		} else if !prior.IsNull() {
+			deletes = append(deletes, key)
+		}
+	}
+	setInt64 := func(key string, planned types.Int64, prior types.Int64) {
+		if !planned.IsNull() {
+			params[key] = planned.ValueInt64()
+		} else if !prior.IsNull() {
+			deletes = append(deletes, key)
+		}
+	}
+
+	if state == nil {
+		state = &metricsServerResourceModel{}
+	}
+	setString("bucket", plan.Bucket, state.Bucket)
+	if !plan.Enable.IsNull() {
+		params["disable"] = boolToInt(!plan.Enable.ValueBool())
+	} else if !state.Enable.IsNull() {
+		deletes = append(deletes, "disable")
+	}
+	setInt64("mtu", plan.MTU, state.MTU)
+	setString("organization", plan.Organization, state.Organization)
+	params["port"] = plan.Port.ValueInt64()
+	params["server"] = plan.Server.ValueString()
+	setString("token", plan.Token, state.Token)
+	if len(deletes) > 0 {
+		params["delete"] = strings.Join(deletes, ",")
+	}
+	return params
+}
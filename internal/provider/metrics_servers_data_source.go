@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &metricsServersDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricsServersDataSource{}
+)
+
+func NewMetricsServersDataSource() datasource.DataSource {
+	return &metricsServersDataSource{}
+}
+
+type metricsServersDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type metricsServersDataSourceModel struct {
+	Data []metricsServersDataSourceServerModel `tfsdk:"data"`
+}
+
+type metricsServersDataSourceServerModel struct {
+	Bucket            types.String `tfsdk:"bucket"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	ID                types.String `tfsdk:"id"`
+	InfluxDBProto     types.String `tfsdk:"influxdb_proto"`
+	MTU               types.Int64  `tfsdk:"mtu"`
+	Organization      types.String `tfsdk:"organization"`
+	Path              types.String `tfsdk:"path"`
+	Port              types.Int64  `tfsdk:"port"`
+	Proto             types.String `tfsdk:"proto"`
+	Server            types.String `tfsdk:"server"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	Type              types.String `tfsdk:"type"`
+	VerifyCertificate types.Bool   `tfsdk:"verify_certificate"`
+}
+
+func (d *metricsServersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *metricsServersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_metrics_servers"
+}
+
+func (d *metricsServersDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the external metric servers (InfluxDB or Graphite) configured for the cluster.",
+		MarkdownDescription: "Retrieves the external metric servers (InfluxDB or Graphite) configured for the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"bucket": schema.StringAttribute{
+							Description:         "InfluxDB bucket; null for other server types",
+							MarkdownDescription: "InfluxDB bucket; null for other server types",
+							Computed:            true,
+						},
+						"enable": schema.BoolAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"influxdb_proto": schema.StringAttribute{
+							Description:         "Protocol used to send metrics to InfluxDB (udp, http or https)",
+							MarkdownDescription: "Protocol used to send metrics to InfluxDB (`udp`, `http` or `https`)",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							Computed: true,
+						},
+						"organization": schema.StringAttribute{
+							Description:         "InfluxDB organization; null for other server types",
+							MarkdownDescription: "InfluxDB organization; null for other server types",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							Description:         "Graphite root path; null for other server types",
+							MarkdownDescription: "Graphite root path; null for other server types",
+							Computed:            true,
+						},
+						"port": schema.Int64Attribute{
+							Computed: true,
+						},
+						"proto": schema.StringAttribute{
+							Description:         "Protocol used to send metrics to Graphite (udp or tcp)",
+							MarkdownDescription: "Protocol used to send metrics to Graphite (`udp` or `tcp`)",
+							Computed:            true,
+						},
+						"server": schema.StringAttribute{
+							Computed: true,
+						},
+						"timeout": schema.Int64Attribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Server type, either 'influxdb' or 'graphite'",
+							MarkdownDescription: "Server type, either `influxdb` or `graphite`",
+							Computed:            true,
+						},
+						"verify_certificate": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *metricsServersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// query for the metric servers; the list omits the protocol-specific options so each one is retrieved
+	var servers []map[string]any
+	if err := d.providerData.client.Get(ctx, "/cluster/metrics/server", &servers); err != nil {
+		tflog.Error(ctx, "failed to retrieve metric servers", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Metric Servers",
+			fmt.Sprintf("Failed to retrieve the external metric servers:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := metricsServersDataSourceModel{
+		Data: []metricsServersDataSourceServerModel{},
+	}
+	for _, server := range servers {
+		id := configString(server, "id").ValueString()
+		var config map[string]any
+		err := d.providerData.client.Get(ctx, fmt.Sprintf("/cluster/metrics/server/%s", url.PathEscape(id)),
+			&config)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve Metric Server",
+				fmt.Sprintf("Failed to retrieve the external metric server '%s':\n\t%s", id, err.Error()),
+			)
+			return
+		}
+		config["id"] = id
+		state.Data = append(state.Data, metricsServerModel(config))
+	}
+	sort.Slice(state.Data, func(i, j int) bool {
+		return state.Data[i].ID.ValueString() < state.Data[j].ID.ValueString()
+	})
+
+	// set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// metricsServerModel converts the raw configuration of a metric server into the model.
+func metricsServerModel(config map[string]any) metricsServersDataSourceServerModel {
+	return metricsServersDataSourceServerModel{
+		Bucket:            configString(config, "bucket"),
+		Enable:            types.BoolValue(!configBool(config, "disable", types.BoolNull()).ValueBool()),
+		ID:                configString(config, "id"),
+		InfluxDBProto:     configString(config, "influxdbproto"),
+		MTU:               configInt64(config, "mtu"),
+		Organization:      configString(config, "organization"),
+		Path:              configString(config, "path"),
+		Port:              configInt64(config, "port"),
+		Proto:             configString(config, "proto"),
+		Server:            configString(config, "server"),
+		Timeout:           configInt64(config, "timeout"),
+		Type:              configString(config, "type"),
+		VerifyCertificate: configBool(config, "verify-certificate", types.BoolNull()),
+	}
+}
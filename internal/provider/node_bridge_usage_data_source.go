@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeBridgeUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeBridgeUsageDataSource{}
+)
+
+func NewNodeBridgeUsageDataSource() datasource.DataSource {
+	return &nodeBridgeUsageDataSource{}
+}
+
+type nodeBridgeUsageDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeBridgeUsageDataSourceModel struct {
+	Data   []nodeBridgeUsageDataSourceBridgeModel `tfsdk:"data"`
+	Filter *nodeBridgeUsageDataSourceFilterModel  `tfsdk:"filter"`
+}
+
+type nodeBridgeUsageDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type nodeBridgeUsageDataSourceBridgeModel struct {
+	Bridge     types.String                              `tfsdk:"bridge"`
+	Exists     types.Bool                                `tfsdk:"exists"`
+	Interfaces []nodeBridgeUsageDataSourceInterfaceModel `tfsdk:"interfaces"`
+}
+
+type nodeBridgeUsageDataSourceInterfaceModel struct {
+	HardwareAddress types.String `tfsdk:"mac_addr"`
+	Interface       types.String `tfsdk:"interface"`
+	VMID            types.Int32  `tfsdk:"vm_id"`
+	VMName          types.String `tfsdk:"vm_name"`
+}
+
+func (d *nodeBridgeUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeBridgeUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_bridge_usage"
+}
+
+func (d *nodeBridgeUsageDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Network interfaces of the VMs on a cluster node grouped by the bridge they are attached to, " +
+			"sorted by bridge name. Bridges without any attached interface are included with an empty list, and " +
+			"bridges which are referenced by a VM but not configured on the node are included with exists set " +
+			"to false. The configuration of every VM on the node is read.",
+		MarkdownDescription: "Network interfaces of the VMs on a cluster node grouped by the bridge they are " +
+			"attached to, sorted by bridge name. Bridges without any attached interface are included with an " +
+			"empty list, and bridges which are referenced by a VM but not configured on the node are included " +
+			"with `exists` set to `false`. The configuration of every VM on the node is read.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"bridge": schema.StringAttribute{
+							Computed: true,
+						},
+						"exists": schema.BoolAttribute{
+							Description:         "Whether or not the bridge is configured on the node",
+							MarkdownDescription: "Whether or not the bridge is configured on the node",
+							Computed:            true,
+						},
+						"interfaces": schema.ListNestedAttribute{
+							Description: "Network interfaces attached to the bridge, sorted by VM ID and " +
+								"interface",
+							MarkdownDescription: "Network interfaces attached to the bridge, sorted by VM ID and " +
+								"interface",
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"interface": schema.StringAttribute{
+										Description:         "Name of the network interface (eg: net0)",
+										MarkdownDescription: "Name of the network interface (eg: `net0`)",
+										Computed:            true,
+									},
+									"mac_addr": schema.StringAttribute{
+										Computed: true,
+									},
+									"vm_id": schema.Int32Attribute{
+										Computed: true,
+									},
+									"vm_name": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description:         "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` when omitted",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeBridgeUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeBridgeUsageDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeBridgeUsageDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the bridge "+
+				"usage or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the bridges and VMs of the node
+	bridges, err := d.providerData.nodeBridges(ctx, nodeName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Node Network",
+			fmt.Sprintf("Failed to retrieve the bridges of the cluster node '%s':\n\t%s", nodeName, err.Error()),
+		)
+		return
+	}
+	resources, err := d.providerData.clusterVMResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+	resources = slices.DeleteFunc(resources, func(res *proxmox.ClusterResource) bool {
+		return res.Type != guestTypeQEMU || res.Node != nodeName
+	})
+	slices.SortFunc(resources, func(a, b *proxmox.ClusterResource) int {
+		return cmp.Compare(a.VMID, b.VMID)
+	})
+
+	// group the network interfaces of every VM by their bridge
+	usage := map[string][]nodeBridgeUsageDataSourceInterfaceModel{}
+	for name := range bridges {
+		usage[name] = []nodeBridgeUsageDataSourceInterfaceModel{}
+	}
+	for _, res := range resources {
+		vmID := int(res.VMID)
+		rawConfig, err := d.providerData.rawVMConfig(ctx, nodeName, vmID)
+		if isNotFoundError(err) {
+			// the VM was removed or migrated since the resources were listed
+			tflog.Debug(ctx, "skipping VM which no longer exists", map[string]any{"vm_id": vmID})
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve VM Config",
+				fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+					vmID, err.Error()),
+			)
+			return
+		}
+		nets := map[string]string{}
+		for key := range rawConfig {
+			if prefix, index := splitConfigKey(key); prefix == "net" && index >= 0 {
+				nets[key] = configString(rawConfig, key).ValueString()
+			}
+		}
+		for _, name := range sortedConfigKeys(nets) {
+			properties := normalizeNetConfig(nets[name])
+			bridge, ok := properties["bridge"]
+			if !ok || bridge == "" {
+				continue
+			}
+			usage[bridge] = append(usage[bridge], nodeBridgeUsageDataSourceInterfaceModel{
+				HardwareAddress: propertyString(properties, "macaddr"),
+				Interface:       types.StringValue(name),
+				VMID:            types.Int32Value(int32(vmID)),
+				VMName:          types.StringValue(res.Name),
+			})
+		}
+	}
+
+	// map the response to the model
+	state := nodeBridgeUsageDataSourceModel{
+		Data:   []nodeBridgeUsageDataSourceBridgeModel{},
+		Filter: config.Filter,
+	}
+	for name, interfaces := range usage {
+		_, exists := bridges[name]
+		state.Data = append(state.Data, nodeBridgeUsageDataSourceBridgeModel{
+			Bridge:     types.StringValue(name),
+			Exists:     types.BoolValue(exists),
+			Interfaces: interfaces,
+		})
+	}
+	slices.SortFunc(state.Data, func(a, b nodeBridgeUsageDataSourceBridgeModel) int {
+		return cmp.Compare(a.Bridge.ValueString(), b.Bridge.ValueString())
+	})
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
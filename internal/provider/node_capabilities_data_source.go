@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeCapabilitiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeCapabilitiesDataSource{}
+)
+
+func NewNodeCapabilitiesDataSource() datasource.DataSource {
+	return &nodeCapabilitiesDataSource{}
+}
+
+type nodeCapabilitiesDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeCapabilitiesDataSourceModel struct {
+	Data   *nodeCapabilitiesDataSourceDataModel   `tfsdk:"data"`
+	Filter *nodeCapabilitiesDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type nodeCapabilitiesDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type nodeCapabilitiesDataSourceDataModel struct {
+	CPUFlags  map[string][]types.String                 `tfsdk:"cpu_flags"`
+	CPUModels []nodeCapabilitiesDataSourceCPUModelModel `tfsdk:"cpu_models"`
+}
+
+type nodeCapabilitiesDataSourceCPUModelModel struct {
+	Custom types.Bool   `tfsdk:"custom"`
+	Name   types.String `tfsdk:"name"`
+	Vendor types.String `tfsdk:"vendor"`
+}
+
+// qemuCPUModel is a single entry of the QEMU CPU model capabilities of a node.
+type qemuCPUModel struct {
+	Custom int    `json:"custom"`
+	Name   string `json:"name"`
+	Vendor string `json:"vendor"`
+}
+
+// qemuCPUFlag is a single entry of the QEMU CPU flag capabilities of a node.
+type qemuCPUFlag struct {
+	Name string `json:"name"`
+}
+
+func (d *nodeCapabilitiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeCapabilitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_capabilities"
+}
+
+func (d *nodeCapabilitiesDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "QEMU CPU models supported by a cluster node and the CPU flags which can be set for each of " +
+			"them (eg: with cpu=host,flags=+aes), sorted by name. Requires the Sys.Audit privilege on " +
+			"/nodes/{node_name}.",
+		MarkdownDescription: "QEMU CPU models supported by a cluster node and the CPU flags which can be set for " +
+			"each of them (eg: with `cpu=host,flags=+aes`), sorted by name. Requires the `Sys.Audit` privilege " +
+			"on `/nodes/{node_name}`.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"cpu_flags": schema.MapAttribute{
+						Description: "Flags which can be set for each CPU model, keyed by model name; the node " +
+							"reports a single list which applies to every model. Empty with a warning when the " +
+							"node does not report its CPU flags",
+						MarkdownDescription: "Flags which can be set for each CPU model, keyed by model name; the " +
+							"node reports a single list which applies to every model. Empty with a warning when " +
+							"the node does not report its CPU flags",
+						Computed:    true,
+						ElementType: types.ListType{ElemType: types.StringType},
+					},
+					"cpu_models": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"custom": schema.BoolAttribute{
+									Description:         "Whether or not the model is a custom CPU model",
+									MarkdownDescription: "Whether or not the model is a custom CPU model",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"vendor": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` " +
+							"when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeCapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeCapabilitiesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeCapabilitiesDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the node "+
+				"capabilities or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the CPU models and flags; only newer versions report the flags
+	var models []qemuCPUModel
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/capabilities/qemu/cpu",
+		url.PathEscape(nodeName)), &models)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve CPU models", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve CPU Models",
+			fmt.Sprintf("Failed to retrieve the CPU models of the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return
+	}
+	var flags []qemuCPUFlag
+	err = d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/capabilities/qemu/cpu-flags",
+		url.PathEscape(nodeName)), &flags)
+	if err != nil {
+		tflog.Warn(ctx, "CPU flags are unavailable", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddWarning(
+			"CPU Flags Unavailable",
+			fmt.Sprintf("The cluster node '%s' did not report its CPU flags, so no flags are returned:\n\t%s",
+				nodeName, err.Error()),
+		)
+	}
+
+	// map the response to the model
+	slices.SortFunc(models, func(a, b qemuCPUModel) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	flagNames := []types.String{}
+	for _, flag := range flags {
+		if flag.Name != "" {
+			flagNames = append(flagNames, types.StringValue(flag.Name))
+		}
+	}
+	state := nodeCapabilitiesDataSourceModel{
+		Data: &nodeCapabilitiesDataSourceDataModel{
+			CPUFlags:  map[string][]types.String{},
+			CPUModels: []nodeCapabilitiesDataSourceCPUModelModel{},
+		},
+		Filter: config.Filter,
+	}
+	for _, model := range models {
+		state.Data.CPUModels = append(state.Data.CPUModels, nodeCapabilitiesDataSourceCPUModelModel{
+			Custom: types.BoolValue(model.Custom != 0),
+			Name:   types.StringValue(model.Name),
+			Vendor: types.StringValue(model.Vendor),
+		})
+		if len(flagNames) > 0 {
+			state.Data.CPUFlags[model.Name] = flagNames
+		}
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+const (
+	// acmeCertificateFilename is the name of the certificate file which ACME certificates are installed as.
+	acmeCertificateFilename = "pveproxy-ssl.pem"
+
+	defaultCertificateRenewBeforeDays = 30
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &nodeCertificateOrderResource{}
+	_ resource.ResourceWithConfigure      = &nodeCertificateOrderResource{}
+	_ resource.ResourceWithImportState    = &nodeCertificateOrderResource{}
+	_ resource.ResourceWithValidateConfig = &nodeCertificateOrderResource{}
+)
+
+func NewNodeCertificateOrderResource() resource.Resource {
+	return &nodeCertificateOrderResource{}
+}
+
+type nodeCertificateOrderResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeCertificateOrderResourceModel struct {
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	Force             types.Bool   `tfsdk:"force"`
+	NodeName          types.String `tfsdk:"node_name"`
+	NotAfter          types.Int64  `tfsdk:"notafter"`
+	NotAfterFormatted types.String `tfsdk:"notafter_formatted"`
+	RenewBeforeDays   types.Int64  `tfsdk:"renew_before_days"`
+}
+
+// nodeCertificate is a single certificate as returned by the certificate info endpoint.
+type nodeCertificate struct {
+	Filename    string `json:"filename"`
+	Fingerprint string `json:"fingerprint"`
+	NotAfter    int64  `json:"notafter"`
+}
+
+func (r *nodeCertificateOrderResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *nodeCertificateOrderResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_certificate_order"
+}
+
+func (r *nodeCertificateOrderResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Orders an ACME certificate for a node using the node's ACME configuration. The certificate " +
+			"is ordered again once it is about to expire. Destroying the resource leaves the certificate in place. " +
+			"An existing ACME certificate is imported using the node name.",
+		MarkdownDescription: "Orders an ACME certificate for a node using the node's ACME configuration. The " +
+			"certificate is ordered again once it is about to expire. Destroying the resource leaves the " +
+			"certificate in place. An existing ACME certificate is imported using the `node_name`.",
+		Attributes: map[string]schema.Attribute{
+			"fingerprint": schema.StringAttribute{
+				Description:         "SHA-256 fingerprint of the ordered certificate",
+				MarkdownDescription: "SHA-256 fingerprint of the ordered certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Overwrite an existing custom certificate when ordering",
+				MarkdownDescription: "Overwrite an existing custom certificate when ordering",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notafter": schema.Int64Attribute{
+				Description:         "Expiry of the ordered certificate as a Unix timestamp",
+				MarkdownDescription: "Expiry of the ordered certificate as a Unix timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"notafter_formatted": schema.StringAttribute{
+				Description:         "Expiry of the ordered certificate in the provider's time_format",
+				MarkdownDescription: "Expiry of the ordered certificate in the provider's `time_format`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"renew_before_days": schema.Int64Attribute{
+				Description: fmt.Sprintf("Order a new certificate when the current one expires within this "+
+					"many days; defaults to %d", defaultCertificateRenewBeforeDays),
+				MarkdownDescription: fmt.Sprintf("Order a new certificate when the current one expires within "+
+					"this many days; defaults to `%d`", defaultCertificateRenewBeforeDays),
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *nodeCertificateOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config nodeCertificateOrderResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RenewBeforeDays.IsNull() && !config.RenewBeforeDays.IsUnknown() &&
+		config.RenewBeforeDays.ValueInt64() < 0 {
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("renew_before_days"),
+			"Invalid Renewal Threshold",
+			fmt.Sprintf("The number of days before expiry to renew the certificate must not be negative: %d.",
+				config.RenewBeforeDays.ValueInt64()),
+		)
+	}
+}
+
+func (r *nodeCertificateOrderResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan nodeCertificateOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// order the certificate
+	nodeName := plan.NodeName.ValueString()
+	params := map[string]any{}
+	if plan.Force.ValueBool() {
+		params["force"] = 1
+	}
+	var upid proxmox.UPID
+	err := r.providerData.client.Post(ctx, fmt.Sprintf("/nodes/%s/certificates/acme/certificate",
+		url.PathEscape(nodeName)), params, &upid)
+	if err == nil {
+		task := proxmox.NewTask(upid, r.providerData.client)
+		err = r.providerData.waitForTaskWithLog(ctx, task, func(line string) {
+			tflog.Info(ctx, "certificate order progress", map[string]any{"node_name": nodeName, "log": line})
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Order Certificate",
+			fmt.Sprintf("Failed to order an ACME certificate for the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return
+	}
+
+	// retrieve the new certificate
+	certificate := r.providerData.acmeCertificate(ctx, nodeName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if certificate == nil {
+		resp.Diagnostics.AddError(
+			"Certificate Not Found",
+			fmt.Sprintf("The ACME certificate was ordered but the cluster node '%s' does not report it.", nodeName),
+		)
+		return
+	}
+	plan.Fingerprint = types.StringValue(certificate.Fingerprint)
+	plan.NotAfter = types.Int64Value(certificate.NotAfter)
+	plan.NotAfterFormatted = r.providerData.formatTimestamp(certificate.NotAfter)
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *nodeCertificateOrderResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state nodeCertificateOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// removing the resource when the certificate is gone or about to expire causes a new one to be ordered
+	nodeName := state.NodeName.ValueString()
+	certificate := r.providerData.acmeCertificate(ctx, nodeName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if certificate == nil {
+		tflog.Warn(ctx, "ACME certificate no longer exists", map[string]any{"node_name": nodeName})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	renewBeforeDays := int64(defaultCertificateRenewBeforeDays)
+	if !state.RenewBeforeDays.IsNull() {
+		renewBeforeDays = state.RenewBeforeDays.ValueInt64()
+	}
+	renewAt := time.Unix(certificate.NotAfter, 0).Add(-time.Duration(renewBeforeDays) * 24 * time.Hour)
+	if time.Now().After(renewAt) {
+		tflog.Warn(ctx, "ACME certificate is due for renewal", map[string]any{
+			"node_name": nodeName,
+			"notafter":  time.Unix(certificate.NotAfter, 0).UTC().Format(time.RFC3339),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Fingerprint = types.StringValue(certificate.Fingerprint)
+	state.NotAfter = types.Int64Value(certificate.NotAfter)
+	state.NotAfterFormatted = r.providerData.formatTimestamp(certificate.NotAfter)
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *nodeCertificateOrderResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan; the remaining attributes only affect the next order so there is nothing to update
+	var plan nodeCertificateOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *nodeCertificateOrderResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	// the certificate is left in place rather than revoked
+}
+
+func (r *nodeCertificateOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughID(ctx, path.Root("node_name"), req, resp)
+}
+
+// acmeCertificate returns the ACME certificate installed on the given node, or nil if there is none.
+func (p *proxmoxveProviderData) acmeCertificate(ctx context.Context, nodeName string,
+	diags *diag.Diagnostics) *nodeCertificate {
+
+	var certificates []nodeCertificate
+	err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/certificates/info", url.PathEscape(nodeName)), &certificates)
+	if err != nil {
+		diags.AddError(
+			"Proxmox VE API: Failed to Retrieve Certificates",
+			fmt.Sprintf("Failed to retrieve the certificates of the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return nil
+	}
+	for _, certificate := range certificates {
+		if certificate.Filename == acmeCertificateFilename {
+			return &certificate
+		}
+	}
+	return nil
+}
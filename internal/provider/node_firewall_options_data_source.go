@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeFirewallOptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeFirewallOptionsDataSource{}
+)
+
+func NewNodeFirewallOptionsDataSource() datasource.DataSource {
+	return &nodeFirewallOptionsDataSource{}
+}
+
+type nodeFirewallOptionsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeFirewallOptionsDataSourceModel struct {
+	Data   *nodeFirewallOptionsDataSourceDataModel   `tfsdk:"data"`
+	Filter *nodeFirewallOptionsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type nodeFirewallOptionsDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type nodeFirewallOptionsDataSourceDataModel struct {
+	Enable                           types.Bool   `tfsdk:"enable"`
+	LogLevelIn                       types.String `tfsdk:"log_level_in"`
+	LogLevelOut                      types.String `tfsdk:"log_level_out"`
+	LogNFConntrack                   types.Bool   `tfsdk:"log_nf_conntrack"`
+	NDP                              types.Bool   `tfsdk:"ndp"`
+	NFConntrackAllowInvalid          types.Bool   `tfsdk:"nf_conntrack_allow_invalid"`
+	NFConntrackMax                   types.Int64  `tfsdk:"nf_conntrack_max"`
+	NFConntrackTCPTimeoutEstablished types.Int64  `tfsdk:"nf_conntrack_tcp_timeout_established"`
+	NFConntrackTCPTimeoutSynRecv     types.Int64  `tfsdk:"nf_conntrack_tcp_timeout_syn_recv"`
+	NoSmurfs                         types.Bool   `tfsdk:"nosmurfs"`
+	SmurfLogLevel                    types.String `tfsdk:"smurf_log_level"`
+	TCPFlags                         types.Bool   `tfsdk:"tcpflags"`
+	TCPFlagsLogLevel                 types.String `tfsdk:"tcp_flags_log_level"`
+}
+
+func (d *nodeFirewallOptionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeFirewallOptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_firewall_options"
+}
+
+func (d *nodeFirewallOptionsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						Computed: true,
+					},
+					"log_level_in": schema.StringAttribute{
+						Computed: true,
+					},
+					"log_level_out": schema.StringAttribute{
+						Computed: true,
+					},
+					"log_nf_conntrack": schema.BoolAttribute{
+						Computed: true,
+					},
+					"ndp": schema.BoolAttribute{
+						Computed: true,
+					},
+					"nf_conntrack_allow_invalid": schema.BoolAttribute{
+						Computed: true,
+					},
+					"nf_conntrack_max": schema.Int64Attribute{
+						Computed: true,
+					},
+					"nf_conntrack_tcp_timeout_established": schema.Int64Attribute{
+						Computed: true,
+					},
+					"nf_conntrack_tcp_timeout_syn_recv": schema.Int64Attribute{
+						Computed: true,
+					},
+					"nosmurfs": schema.BoolAttribute{
+						Computed: true,
+					},
+					"smurf_log_level": schema.StringAttribute{
+						Computed: true,
+					},
+					"tcpflags": schema.BoolAttribute{
+						Computed: true,
+					},
+					"tcp_flags_log_level": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` " +
+							"when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeFirewallOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeFirewallOptionsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeFirewallOptionsDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the node "+
+				"firewall options or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the firewall options
+	var options map[string]any
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/firewall/options", url.PathEscape(nodeName)), &options)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve node firewall options", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Node Firewall Options",
+			fmt.Sprintf("Failed to retrieve the firewall options of the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := nodeFirewallOptionsDataSourceModel{
+		Data: &nodeFirewallOptionsDataSourceDataModel{
+			Enable:                           configBool(options, "enable", types.BoolNull()),
+			LogLevelIn:                       configString(options, "log_level_in"),
+			LogLevelOut:                      configString(options, "log_level_out"),
+			LogNFConntrack:                   configBool(options, "log_nf_conntrack", types.BoolNull()),
+			NDP:                              configBool(options, "ndp", types.BoolNull()),
+			NFConntrackAllowInvalid:          configBool(options, "nf_conntrack_allow_invalid", types.BoolNull()),
+			NFConntrackMax:                   configInt64(options, "nf_conntrack_max"),
+			NFConntrackTCPTimeoutEstablished: configInt64(options, "nf_conntrack_tcp_timeout_established"),
+			NFConntrackTCPTimeoutSynRecv:     configInt64(options, "nf_conntrack_tcp_timeout_syn_recv"),
+			NoSmurfs:                         configBool(options, "nosmurfs", types.BoolNull()),
+			SmurfLogLevel:                    configString(options, "smurf_log_level"),
+			TCPFlags:                         configBool(options, "tcpflags", types.BoolNull()),
+			TCPFlagsLogLevel:                 configString(options, "tcp_flags_log_level"),
+		},
+		Filter: config.Filter,
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
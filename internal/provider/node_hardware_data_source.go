@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeHardwareDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeHardwareDataSource{}
+)
+
+func NewNodeHardwareDataSource() datasource.DataSource {
+	return &nodeHardwareDataSource{}
+}
+
+type nodeHardwareDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeHardwareDataSourceModel struct {
+	Data   *nodeHardwareDataSourceDataModel   `tfsdk:"data"`
+	Filter *nodeHardwareDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type nodeHardwareDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type nodeHardwareDataSourceDataModel struct {
+	CPUCores      types.Int64   `tfsdk:"cpu_cores"`
+	CPUMHz        types.Float64 `tfsdk:"cpu_mhz"`
+	CPUModel      types.String  `tfsdk:"cpu_model"`
+	CPUSockets    types.Int64   `tfsdk:"cpu_sockets"`
+	CPUThreads    types.Int64   `tfsdk:"cpu_threads"`
+	KernelVersion types.String  `tfsdk:"kernel_version"`
+	PVEVersion    types.String  `tfsdk:"pve_version"`
+	TotalMemory   types.Int64   `tfsdk:"total_memory"`
+}
+
+// nodeStatus is the subset of the response from the node status endpoint describing the node's hardware, memory
+// usage and how long it has been running.
+type nodeStatus struct {
+	CPUInfo struct {
+		CPUs    int                     `json:"cpus"`
+		Cores   int                     `json:"cores"`
+		MHz     proxmox.StringOrFloat64 `json:"mhz"`
+		Model   string                  `json:"model"`
+		Sockets int                     `json:"sockets"`
+	} `json:"cpuinfo"`
+	KernelVersion string         `json:"kversion"`
+	KSM           proxmox.Ksm    `json:"ksm"`
+	Memory        proxmox.Memory `json:"memory"`
+	PVEVersion    string         `json:"pveversion"`
+	Uptime        int64          `json:"uptime"`
+}
+
+func (d *nodeHardwareDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeHardwareDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_hardware"
+}
+
+func (d *nodeHardwareDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Hardware details of a cluster node. Requires the Sys.Audit privilege on /nodes/{node_name}.",
+		MarkdownDescription: "Hardware details of a cluster node. Requires the `Sys.Audit` privilege on " +
+			"`/nodes/{node_name}`.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"cpu_cores": schema.Int64Attribute{
+						Description:         "Number of CPU cores per socket",
+						MarkdownDescription: "Number of CPU cores per socket",
+						Computed:            true,
+					},
+					"cpu_mhz": schema.Float64Attribute{
+						Computed: true,
+					},
+					"cpu_model": schema.StringAttribute{
+						Computed: true,
+					},
+					"cpu_sockets": schema.Int64Attribute{
+						Computed: true,
+					},
+					"cpu_threads": schema.Int64Attribute{
+						Description:         "Total number of logical CPUs",
+						MarkdownDescription: "Total number of logical CPUs",
+						Computed:            true,
+					},
+					"kernel_version": schema.StringAttribute{
+						Computed: true,
+					},
+					"pve_version": schema.StringAttribute{
+						Computed: true,
+					},
+					"total_memory": schema.Int64Attribute{
+						Description:         "Total memory in bytes",
+						MarkdownDescription: "Total memory in bytes",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` " +
+							"when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeHardwareDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeHardwareDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeHardwareDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the node "+
+				"hardware or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the node status
+	var status nodeStatus
+	if err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/status",
+		url.PathEscape(nodeName)), &status); err != nil {
+		tflog.Error(ctx, "failed to retrieve node status", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Node Status",
+			fmt.Sprintf("Failed to retrieve the status of the cluster node '%s':\n\t%s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := nodeHardwareDataSourceModel{
+		Data: &nodeHardwareDataSourceDataModel{
+			CPUCores:      types.Int64Value(int64(status.CPUInfo.Cores)),
+			CPUMHz:        types.Float64Value(float64(status.CPUInfo.MHz)),
+			CPUModel:      types.StringValue(status.CPUInfo.Model),
+			CPUSockets:    types.Int64Value(int64(status.CPUInfo.Sockets)),
+			CPUThreads:    types.Int64Value(int64(status.CPUInfo.CPUs)),
+			KernelVersion: types.StringValue(status.KernelVersion),
+			PVEVersion:    types.StringValue(status.PVEVersion),
+			TotalMemory:   uint64Value(status.Memory.Total),
+		},
+		Filter: config.Filter,
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeKSMDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeKSMDataSource{}
+)
+
+func NewNodeKSMDataSource() datasource.DataSource {
+	return &nodeKSMDataSource{}
+}
+
+type nodeKSMDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeKSMDataSourceModel struct {
+	Data   *nodeKSMDataSourceDataModel   `tfsdk:"data"`
+	Filter *nodeKSMDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type nodeKSMDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type nodeKSMDataSourceDataModel struct {
+	Active        types.Bool    `tfsdk:"active"`
+	Shared        types.Int64   `tfsdk:"shared"`
+	SharedPercent types.Float64 `tfsdk:"shared_percent"`
+	TotalMemory   types.Int64   `tfsdk:"total_memory"`
+	UsedMemory    types.Int64   `tfsdk:"used_memory"`
+}
+
+func (d *nodeKSMDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeKSMDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_ksm"
+}
+
+func (d *nodeKSMDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Kernel Samepage Merging (KSM) statistics of a cluster node for tuning memory overcommit. " +
+			"All values are zero when KSM is not active. Requires the Sys.Audit privilege on /nodes/{node_name}.",
+		MarkdownDescription: "Kernel Samepage Merging (KSM) statistics of a cluster node for tuning memory " +
+			"overcommit. All values are zero when KSM is not active. Requires the `Sys.Audit` privilege on " +
+			"`/nodes/{node_name}`.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"active": schema.BoolAttribute{
+						Description:         "Whether or not KSM is currently sharing any memory",
+						MarkdownDescription: "Whether or not KSM is currently sharing any memory",
+						Computed:            true,
+					},
+					"shared": schema.Int64Attribute{
+						Description:         "Memory in bytes which KSM has merged into shared pages",
+						MarkdownDescription: "Memory in bytes which KSM has merged into shared pages",
+						Computed:            true,
+					},
+					"shared_percent": schema.Float64Attribute{
+						Description:         "Shared memory as a percentage of the node's used memory",
+						MarkdownDescription: "Shared memory as a percentage of the node's used memory",
+						Computed:            true,
+					},
+					"total_memory": schema.Int64Attribute{
+						Description:         "Total memory in bytes",
+						MarkdownDescription: "Total memory in bytes",
+						Computed:            true,
+					},
+					"used_memory": schema.Int64Attribute{
+						Description:         "Used memory in bytes",
+						MarkdownDescription: "Used memory in bytes",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` " +
+							"when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeKSMDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeKSMDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeKSMDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the KSM "+
+				"statistics or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the node status
+	var status nodeStatus
+	if err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/status",
+		url.PathEscape(nodeName)), &status); err != nil {
+		tflog.Error(ctx, "failed to retrieve node status", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Node Status",
+			fmt.Sprintf("Failed to retrieve the status of the cluster node '%s':\n\t%s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model; the API omits the KSM statistics when KSM is not running so they are
+	// reported as zero
+	shared := max(status.KSM.Shared, 0)
+	sharedPercent := 0.0
+	if status.Memory.Used > 0 {
+		sharedPercent = float64(shared) / float64(status.Memory.Used) * 100
+	}
+	state := nodeKSMDataSourceModel{
+		Data: &nodeKSMDataSourceDataModel{
+			Active:        types.BoolValue(shared > 0),
+			Shared:        types.Int64Value(shared),
+			SharedPercent: types.Float64Value(sharedPercent),
+			TotalMemory:   uint64Value(status.Memory.Total),
+			UsedMemory:    uint64Value(status.Memory.Used),
+		},
+		Filter: config.Filter,
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
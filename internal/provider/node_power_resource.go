@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	nodePowerActionReboot   = "reboot"
+	nodePowerActionShutdown = "shutdown"
+
+	// nodeOnlineTimeout caps how long to wait for a rebooted node to come back online.
+	nodeOnlineTimeout = 15 * time.Minute
+)
+
+// nodePowerActions are the supported power actions for a node.
+var nodePowerActions = []string{nodePowerActionReboot, nodePowerActionShutdown}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &nodePowerResource{}
+	_ resource.ResourceWithConfigure      = &nodePowerResource{}
+	_ resource.ResourceWithValidateConfig = &nodePowerResource{}
+)
+
+func NewNodePowerResource() resource.Resource {
+	return &nodePowerResource{}
+}
+
+type nodePowerResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodePowerResourceModel struct {
+	Action        types.String `tfsdk:"action"`
+	Confirm       types.Bool   `tfsdk:"confirm"`
+	NodeName      types.String `tfsdk:"node_name"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	WaitForOnline types.Bool   `tfsdk:"wait_for_online"`
+}
+
+func (r *nodePowerResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *nodePowerResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_power"
+}
+
+func (r *nodePowerResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Reboots or shuts down a cluster node when the resource is created or replaced. WARNING: " +
+			"every guest on the node which is not migrated beforehand is stopped, a node which is shut down can " +
+			"only be started again out of band and, if the provider's endpoint is the node itself, the API is " +
+			"unavailable until it is back. Destroying the resource does nothing.",
+		MarkdownDescription: "Reboots or shuts down a cluster node when the resource is created or replaced.\n\n" +
+			"~> **WARNING:** every guest on the node which is not migrated beforehand is stopped, a node which is " +
+			"shut down can only be started again out of band and, if the provider's `endpoint` is the node itself, " +
+			"the API is unavailable until it is back. Destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				Description:         "Power action to perform (reboot or shutdown)",
+				MarkdownDescription: "Power action to perform (`reboot` or `shutdown`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"confirm": schema.BoolAttribute{
+				Description:         "Must be set to true to acknowledge that the node will be rebooted or shut down",
+				MarkdownDescription: "Must be set to `true` to acknowledge that the node will be rebooted or shut down",
+				Required:            true,
+			},
+			"node_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description:         "Arbitrary values which cause the power action to be performed again when changed",
+				MarkdownDescription: "Arbitrary values which cause the power action to be performed again when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_online": schema.BoolAttribute{
+				Description: fmt.Sprintf("Wait up to %s for a rebooted node to report that it has restarted; only "+
+					"valid for the reboot action", nodeOnlineTimeout),
+				MarkdownDescription: fmt.Sprintf("Wait up to %s for a rebooted node to report that it has "+
+					"restarted; only valid for the `reboot` action", nodeOnlineTimeout),
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *nodePowerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config nodePowerResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Confirm.IsUnknown() && !config.Confirm.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("confirm"),
+			"Node Power Action Not Confirmed",
+			"Rebooting or shutting down a node stops every guest running on it. Set confirm to true to "+
+				"acknowledge this.",
+		)
+	}
+	if config.Action.IsUnknown() {
+		return
+	}
+	action := config.Action.ValueString()
+	if !slices.Contains(nodePowerActions, action) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("action"),
+			"Invalid Node Power Action",
+			fmt.Sprintf("The node power action '%s' is not supported; it must be one of: %v.", action,
+				nodePowerActions),
+		)
+	}
+	if config.WaitForOnline.ValueBool() && action != nodePowerActionReboot {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_online"),
+			"Invalid Wait For Online",
+			fmt.Sprintf("Waiting for the node to come back online is only supported for the '%s' action.",
+				nodePowerActionReboot),
+		)
+	}
+}
+
+func (r *nodePowerResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan nodePowerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// perform the power action
+	nodeName := plan.NodeName.ValueString()
+	action := plan.Action.ValueString()
+	tflog.Warn(ctx, "performing node power action", map[string]any{"node_name": nodeName, "action": action})
+	requested := time.Now()
+	err := r.providerData.client.Post(ctx, fmt.Sprintf("/nodes/%s/status", url.PathEscape(nodeName)),
+		map[string]any{"command": action}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Perform Node Power Action",
+			fmt.Sprintf("Failed to %s the cluster node '%s':\n\t%s", action, nodeName, err.Error()),
+		)
+		return
+	}
+	if action == nodePowerActionReboot && plan.WaitForOnline.ValueBool() {
+		if err := r.providerData.waitForNodeRestart(ctx, nodeName, requested); err != nil {
+			resp.Diagnostics.AddError(
+				"Node Did Not Come Back Online",
+				fmt.Sprintf("The cluster node '%s' was rebooted but did not come back online:\n\t%s", nodeName,
+					err.Error()),
+			)
+			return
+		}
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *nodePowerResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state; the action has no lasting state to refresh
+	var state nodePowerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *nodePowerResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan; the remaining attributes only affect the next power action so there is nothing to update
+	var plan nodePowerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *nodePowerResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	// a power action cannot be undone
+}
+
+// waitForNodeRestart polls the status of the given node until it reports an uptime shorter than the time which
+// has passed since the reboot was requested. Failed requests are expected while the node is down and are
+// retried with the task poll backoff until the node online timeout is reached.
+func (p *proxmoxveProviderData) waitForNodeRestart(ctx context.Context, nodeName string,
+	requested time.Time) error {
+
+	deadline := requested.Add(nodeOnlineTimeout)
+	interval := p.taskPoll.minInterval
+	for {
+		var status nodeStatus
+		err := p.client.Get(ctx, fmt.Sprintf("/nodes/%s/status", url.PathEscape(nodeName)), &status)
+		if err == nil && time.Duration(status.Uptime)*time.Second <= time.Since(requested) {
+			tflog.Info(ctx, "node is back online", map[string]any{"node_name": nodeName, "uptime": status.Uptime})
+			return nil
+		}
+		if err != nil {
+			tflog.Debug(ctx, "node is not online yet", map[string]any{"node_name": nodeName, "error": err.Error()})
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("timed out after %s", nodeOnlineTimeout)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		interval = p.taskPoll.nextInterval(interval)
+	}
+}
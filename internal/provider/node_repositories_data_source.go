@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+const (
+	nodeRepositoryStatusDisabled      = "disabled"
+	nodeRepositoryStatusEnabled       = "enabled"
+	nodeRepositoryStatusNotConfigured = "not_configured"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeRepositoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeRepositoriesDataSource{}
+)
+
+func NewNodeRepositoriesDataSource() datasource.DataSource {
+	return &nodeRepositoriesDataSource{}
+}
+
+type nodeRepositoriesDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeRepositoriesDataSourceModel struct {
+	Data   *nodeRepositoriesDataSourceDataModel   `tfsdk:"data"`
+	Filter *nodeRepositoriesDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type nodeRepositoriesDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type nodeRepositoriesDataSourceDataModel struct {
+	Repositories         []nodeRepositoriesDataSourceRepositoryModel         `tfsdk:"repositories"`
+	StandardRepositories []nodeRepositoriesDataSourceStandardRepositoryModel `tfsdk:"standard_repositories"`
+}
+
+type nodeRepositoriesDataSourceRepositoryModel struct {
+	Comment    types.String   `tfsdk:"comment"`
+	Components []types.String `tfsdk:"components"`
+	Enabled    types.Bool     `tfsdk:"enabled"`
+	Index      types.Int64    `tfsdk:"index"`
+	Path       types.String   `tfsdk:"path"`
+	Suites     []types.String `tfsdk:"suites"`
+	Types      []types.String `tfsdk:"types"`
+	URIs       []types.String `tfsdk:"uris"`
+}
+
+type nodeRepositoriesDataSourceStandardRepositoryModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Handle  types.String `tfsdk:"handle"`
+	Name    types.String `tfsdk:"name"`
+	Status  types.String `tfsdk:"status"`
+}
+
+// nodeAPTRepositories is the subset of the response from the APT repositories endpoint of a node.
+type nodeAPTRepositories struct {
+	Files         []nodeAPTRepositoryFile `json:"files"`
+	StandardRepos []struct {
+		Handle string             `json:"handle"`
+		Name   string             `json:"name"`
+		Status *proxmox.IntOrBool `json:"status"`
+	} `json:"standard-repos"`
+}
+
+// nodeAPTRepositoryFile is a sources file of a node and the repositories configured in it.
+type nodeAPTRepositoryFile struct {
+	Path         string `json:"path"`
+	Repositories []struct {
+		Comment    string            `json:"Comment"`
+		Components []string          `json:"Components"`
+		Enabled    proxmox.IntOrBool `json:"Enabled"`
+		Suites     []string          `json:"Suites"`
+		Types      []string          `json:"Types"`
+		URIs       []string          `json:"URIs"`
+	} `json:"repositories"`
+}
+
+func (d *nodeRepositoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeRepositoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_repositories"
+}
+
+func (d *nodeRepositoriesDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	stringList := func(description, markdownDescription string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			Computed:            true,
+			ElementType:         types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "APT repositories configured on a cluster node and the state of the standard Proxmox VE " +
+			"repositories (eg: enterprise or no-subscription). Requires the Sys.Audit privilege on " +
+			"/nodes/{node_name}.",
+		MarkdownDescription: "APT repositories configured on a cluster node and the state of the standard Proxmox " +
+			"VE repositories (eg: `enterprise` or `no-subscription`). Requires the `Sys.Audit` privilege on " +
+			"`/nodes/{node_name}`.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"repositories": schema.ListNestedAttribute{
+						Description:         "Repositories configured on the node, sorted by file and position",
+						MarkdownDescription: "Repositories configured on the node, sorted by file and position",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"comment": schema.StringAttribute{
+									Description:         "Comment of the repository; null when unset",
+									MarkdownDescription: "Comment of the repository; null when unset",
+									Computed:            true,
+								},
+								"components": stringList("Components of the repository (eg: pve-no-subscription)",
+									"Components of the repository (eg: `pve-no-subscription`)"),
+								"enabled": schema.BoolAttribute{
+									Computed: true,
+								},
+								"index": schema.Int64Attribute{
+									Description:         "Position of the repository within its file",
+									MarkdownDescription: "Position of the repository within its file",
+									Computed:            true,
+								},
+								"path": schema.StringAttribute{
+									Description:         "Path of the file the repository is configured in",
+									MarkdownDescription: "Path of the file the repository is configured in",
+									Computed:            true,
+								},
+								"suites": stringList("Suites of the repository (eg: bookworm)",
+									"Suites of the repository (eg: `bookworm`)"),
+								"types": stringList("Package types of the repository (eg: deb)",
+									"Package types of the repository (eg: `deb`)"),
+								"uris": stringList("URIs of the repository", "URIs of the repository"),
+							},
+						},
+					},
+					"standard_repositories": schema.ListNestedAttribute{
+						Description:         "Standard Proxmox repositories known to the node, sorted by handle",
+						MarkdownDescription: "Standard Proxmox repositories known to the node, sorted by handle",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									Description:         "Whether or not the repository is configured and enabled",
+									MarkdownDescription: "Whether or not the repository is configured and enabled",
+									Computed:            true,
+								},
+								"handle": schema.StringAttribute{
+									Description:         "Handle of the repository (eg: no-subscription)",
+									MarkdownDescription: "Handle of the repository (eg: `no-subscription`)",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"status": schema.StringAttribute{
+									Description: "State of the repository (enabled, disabled or not_configured)",
+									MarkdownDescription: "State of the repository (`enabled`, `disabled` or " +
+										"`not_configured`)",
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description:         "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` when omitted",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeRepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeRepositoriesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeRepositoriesDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the node "+
+				"repositories or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the repositories
+	var repositories nodeAPTRepositories
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/apt/repositories",
+		url.PathEscape(nodeName)), &repositories)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve node repositories", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Node Repositories",
+			fmt.Sprintf("Failed to retrieve the APT repositories of the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := nodeRepositoriesDataSourceModel{
+		Data: &nodeRepositoriesDataSourceDataModel{
+			Repositories:         []nodeRepositoriesDataSourceRepositoryModel{},
+			StandardRepositories: []nodeRepositoriesDataSourceStandardRepositoryModel{},
+		},
+		Filter: config.Filter,
+	}
+	slices.SortFunc(repositories.Files, func(a, b nodeAPTRepositoryFile) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+	for _, file := range repositories.Files {
+		for i, repository := range file.Repositories {
+			model := nodeRepositoriesDataSourceRepositoryModel{
+				Comment:    types.StringNull(),
+				Components: stringModels(repository.Components),
+				Enabled:    types.BoolValue(bool(repository.Enabled)),
+				Index:      types.Int64Value(int64(i)),
+				Path:       types.StringValue(file.Path),
+				Suites:     stringModels(repository.Suites),
+				Types:      stringModels(repository.Types),
+				URIs:       stringModels(repository.URIs),
+			}
+			if repository.Comment != "" {
+				model.Comment = types.StringValue(repository.Comment)
+			}
+			state.Data.Repositories = append(state.Data.Repositories, model)
+		}
+	}
+	for _, repository := range repositories.StandardRepos {
+		model := nodeRepositoriesDataSourceStandardRepositoryModel{
+			Enabled: types.BoolValue(false),
+			Handle:  types.StringValue(repository.Handle),
+			Name:    types.StringValue(repository.Name),
+			Status:  types.StringValue(nodeRepositoryStatusNotConfigured),
+		}
+		if repository.Status != nil {
+			model.Enabled = types.BoolValue(bool(*repository.Status))
+			model.Status = types.StringValue(nodeRepositoryStatusDisabled)
+			if bool(*repository.Status) {
+				model.Status = types.StringValue(nodeRepositoryStatusEnabled)
+			}
+		}
+		state.Data.StandardRepositories = append(state.Data.StandardRepositories, model)
+	}
+	slices.SortFunc(state.Data.StandardRepositories, func(a, b nodeRepositoriesDataSourceStandardRepositoryModel) int {
+		return cmp.Compare(a.Handle.ValueString(), b.Handle.ValueString())
+	})
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// stringModels converts the given strings into a list of string values.
+func stringModels(values []string) []types.String {
+	models := []types.String{}
+	for _, value := range values {
+		models = append(models, types.StringValue(value))
+	}
+	return models
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultLogLineLimit = 100
+	maxLogLineLimit     = 5000
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeSyslogDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeSyslogDataSource{}
+)
+
+func NewNodeSyslogDataSource() datasource.DataSource {
+	return &nodeSyslogDataSource{}
+}
+
+type nodeSyslogDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeSyslogDataSourceModel struct {
+	Data   []logLineModel                   `tfsdk:"data"`
+	Filter *nodeSyslogDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type nodeSyslogDataSourceFilterModel struct {
+	Limit    types.Int64  `tfsdk:"limit"`
+	NodeName types.String `tfsdk:"node_name"`
+	Service  types.String `tfsdk:"service"`
+	Since    types.String `tfsdk:"since"`
+	Until    types.String `tfsdk:"until"`
+}
+
+// logLineModel is a single numbered line of a log.
+type logLineModel struct {
+	N types.Int64  `tfsdk:"n"`
+	T types.String `tfsdk:"t"`
+}
+
+// logLine is a single numbered line of a log as returned by the API.
+type logLine struct {
+	N int64  `json:"n"`
+	T string `json:"t"`
+}
+
+// logLinesSchema returns the schema for a list of logLineModel.
+func logLinesSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"n": schema.Int64Attribute{
+					Description:         "Line number",
+					MarkdownDescription: "Line number",
+					Computed:            true,
+				},
+				"t": schema.StringAttribute{
+					Description:         "Line text",
+					MarkdownDescription: "Line text",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// logLineLimit returns the number of log lines to request for the given configured limit, or an error if the
+// limit is out of range.
+func logLineLimit(limit types.Int64) (int64, error) {
+	if limit.IsNull() || limit.IsUnknown() {
+		return defaultLogLineLimit, nil
+	}
+	if limit.ValueInt64() < 1 || limit.ValueInt64() > maxLogLineLimit {
+		return 0, fmt.Errorf("the limit must be between 1 and %d: %d", maxLogLineLimit, limit.ValueInt64())
+	}
+	return limit.ValueInt64(), nil
+}
+
+func (d *nodeSyslogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeSyslogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_syslog"
+}
+
+func (d *nodeSyslogDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the most recent lines of a node's system log.",
+		MarkdownDescription: "Retrieves the most recent lines of a node's system log.",
+		Attributes: map[string]schema.Attribute{
+			"data": logLinesSchema(),
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"limit": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of lines to return (default: %d, maximum: %d)",
+							defaultLogLineLimit, maxLogLineLimit),
+						MarkdownDescription: fmt.Sprintf(
+							"Maximum number of lines to return (default: `%d`, maximum: `%d`)",
+							defaultLogLineLimit, maxLogLineLimit),
+						Optional: true,
+					},
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` " +
+							"when omitted",
+						Optional: true,
+					},
+					"service": schema.StringAttribute{
+						Description:         "Only return lines of this systemd unit (eg: pveproxy)",
+						MarkdownDescription: "Only return lines of this systemd unit (eg: `pveproxy`)",
+						Optional:            true,
+					},
+					"since": schema.StringAttribute{
+						Description:         "Only return lines logged since this time (eg: 2024-01-31 12:00:00)",
+						MarkdownDescription: "Only return lines logged since this time (eg: `2024-01-31 12:00:00`)",
+						Optional:            true,
+					},
+					"until": schema.StringAttribute{
+						Description:         "Only return lines logged until this time (eg: 2024-01-31 13:00:00)",
+						MarkdownDescription: "Only return lines logged until this time (eg: `2024-01-31 13:00:00`)",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeSyslogDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeSyslogDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified and the limit is valid
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeSyslogDataSourceFilterModel{
+			Limit:    types.Int64Null(),
+			NodeName: types.StringNull(),
+			Service:  types.StringNull(),
+			Since:    types.StringNull(),
+			Until:    types.StringNull(),
+		}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the system log "+
+				"or configure a default node for the provider.",
+		)
+		return
+	}
+	limit, err := logLineLimit(filter.Limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Filter Limit", fmt.Sprintf("The filter limit is invalid: %s", err.Error()),
+		)
+		return
+	}
+
+	// query for the log lines
+	query := url.Values{}
+	query.Set("limit", strconv.FormatInt(limit, 10))
+	if !filter.Service.IsNull() {
+		query.Set("service", filter.Service.ValueString())
+	}
+	if !filter.Since.IsNull() {
+		query.Set("since", filter.Since.ValueString())
+	}
+	if !filter.Until.IsNull() {
+		query.Set("until", filter.Until.ValueString())
+	}
+	var lines []logLine
+	err = d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/syslog?%s", url.PathEscape(nodeName),
+		query.Encode()), &lines)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve node system log", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Node System Log",
+			fmt.Sprintf("Failed to retrieve the system log of the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := nodeSyslogDataSourceModel{
+		Data:   logLineModels(lines),
+		Filter: config.Filter,
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// logLineModels converts the given log lines into models ordered by line number.
+func logLineModels(lines []logLine) []logLineModel {
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i].N < lines[j].N
+	})
+	models := []logLineModel{}
+	for _, line := range lines {
+		models = append(models, logLineModel{
+			N: types.Int64Value(line.N),
+			T: types.StringValue(line.T),
+		})
+	}
+	return models
+}
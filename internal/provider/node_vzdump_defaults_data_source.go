@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeVzdumpDefaultsDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeVzdumpDefaultsDataSource{}
+)
+
+func NewNodeVzdumpDefaultsDataSource() datasource.DataSource {
+	return &nodeVzdumpDefaultsDataSource{}
+}
+
+type nodeVzdumpDefaultsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type nodeVzdumpDefaultsDataSourceModel struct {
+	Data   *nodeVzdumpDefaultsDataSourceDataModel   `tfsdk:"data"`
+	Filter *nodeVzdumpDefaultsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type nodeVzdumpDefaultsDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type nodeVzdumpDefaultsDataSourceDataModel struct {
+	BWLimit      types.Int64        `tfsdk:"bwlimit"`
+	Compress     types.String       `tfsdk:"compress"`
+	IONice       types.Int64        `tfsdk:"ionice"`
+	MailTo       types.String       `tfsdk:"mailto"`
+	MaxFiles     types.Int64        `tfsdk:"maxfiles"`
+	Mode         types.String       `tfsdk:"mode"`
+	PruneBackups *pruneBackupsModel `tfsdk:"prune_backups"`
+	Storage      types.String       `tfsdk:"storage"`
+}
+
+// pruneBackupsModel is the retention policy of a prune-backups property string.
+type pruneBackupsModel struct {
+	KeepAll     types.Bool  `tfsdk:"keep_all"`
+	KeepDaily   types.Int64 `tfsdk:"keep_daily"`
+	KeepHourly  types.Int64 `tfsdk:"keep_hourly"`
+	KeepLast    types.Int64 `tfsdk:"keep_last"`
+	KeepMonthly types.Int64 `tfsdk:"keep_monthly"`
+	KeepWeekly  types.Int64 `tfsdk:"keep_weekly"`
+	KeepYearly  types.Int64 `tfsdk:"keep_yearly"`
+}
+
+func (d *nodeVzdumpDefaultsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *nodeVzdumpDefaultsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_node_vzdump_defaults"
+}
+
+func (d *nodeVzdumpDefaultsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	keepDescription := func(period string) string {
+		return fmt.Sprintf("Number of %s backups to keep; null when unset", period)
+	}
+	resp.Schema = schema.Schema{
+		Description: "Default backup (vzdump) settings of a cluster node, which apply to every backup job unless " +
+			"the job overrides them. Unset settings are null. Requires the Sys.Audit privilege on " +
+			"/nodes/{node_name}.",
+		MarkdownDescription: "Default backup (vzdump) settings of a cluster node, which apply to every backup job " +
+			"unless the job overrides them. Unset settings are null. Requires the `Sys.Audit` privilege on " +
+			"`/nodes/{node_name}`.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"bwlimit": schema.Int64Attribute{
+						Description:         "Bandwidth limit in KiB/s; 0 means unlimited",
+						MarkdownDescription: "Bandwidth limit in KiB/s; 0 means unlimited",
+						Computed:            true,
+					},
+					"compress": schema.StringAttribute{
+						Description:         "Compression algorithm (0, 1, gzip, lzo or zstd)",
+						MarkdownDescription: "Compression algorithm (`0`, `1`, `gzip`, `lzo` or `zstd`)",
+						Computed:            true,
+					},
+					"ionice": schema.Int64Attribute{
+						Description:         "I/O priority of the backup process (0-8)",
+						MarkdownDescription: "I/O priority of the backup process (0-8)",
+						Computed:            true,
+					},
+					"mailto": schema.StringAttribute{
+						Description:         "Comma-separated list of email addresses notified about backups",
+						MarkdownDescription: "Comma-separated list of email addresses notified about backups",
+						Computed:            true,
+					},
+					"maxfiles": schema.Int64Attribute{
+						Description: "Maximum number of backups per guest; deprecated in favor of " +
+							"prune_backups",
+						MarkdownDescription: "Maximum number of backups per guest; deprecated in favor of " +
+							"`prune_backups`",
+						Computed: true,
+					},
+					"mode": schema.StringAttribute{
+						Description:         "Backup mode (snapshot, suspend or stop)",
+						MarkdownDescription: "Backup mode (`snapshot`, `suspend` or `stop`)",
+						Computed:            true,
+					},
+					"prune_backups": schema.SingleNestedAttribute{
+						Description:         "Retention policy for backups; null when unset",
+						MarkdownDescription: "Retention policy for backups; null when unset",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"keep_all": schema.BoolAttribute{
+								Description:         "Whether or not all backups are kept",
+								MarkdownDescription: "Whether or not all backups are kept",
+								Computed:            true,
+							},
+							"keep_daily": schema.Int64Attribute{
+								Description:         keepDescription("daily"),
+								MarkdownDescription: keepDescription("daily"),
+								Computed:            true,
+							},
+							"keep_hourly": schema.Int64Attribute{
+								Description:         keepDescription("hourly"),
+								MarkdownDescription: keepDescription("hourly"),
+								Computed:            true,
+							},
+							"keep_last": schema.Int64Attribute{
+								Description:         keepDescription("most recent"),
+								MarkdownDescription: keepDescription("most recent"),
+								Computed:            true,
+							},
+							"keep_monthly": schema.Int64Attribute{
+								Description:         keepDescription("monthly"),
+								MarkdownDescription: keepDescription("monthly"),
+								Computed:            true,
+							},
+							"keep_weekly": schema.Int64Attribute{
+								Description:         keepDescription("weekly"),
+								MarkdownDescription: keepDescription("weekly"),
+								Computed:            true,
+							},
+							"keep_yearly": schema.Int64Attribute{
+								Description:         keepDescription("yearly"),
+								MarkdownDescription: keepDescription("yearly"),
+								Computed:            true,
+							},
+						},
+					},
+					"storage": schema.StringAttribute{
+						Description:         "Storage backups are written to",
+						MarkdownDescription: "Storage backups are written to",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node; defaults to the provider's default_node when omitted",
+						MarkdownDescription: "Name of the node; defaults to the provider's `default_node` " +
+							"when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeVzdumpDefaultsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config nodeVzdumpDefaultsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &nodeVzdumpDefaultsDataSourceFilterModel{NodeName: types.StringNull()}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the backup "+
+				"defaults or configure a default node for the provider.",
+		)
+		return
+	}
+
+	// query for the defaults
+	var defaults map[string]any
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/vzdump/defaults", url.PathEscape(nodeName)), &defaults)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve backup defaults", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Backup Defaults",
+			fmt.Sprintf("Failed to retrieve the backup defaults of the cluster node '%s':\n\t%s", nodeName,
+				err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := nodeVzdumpDefaultsDataSourceModel{
+		Data: &nodeVzdumpDefaultsDataSourceDataModel{
+			BWLimit:      configInt64(defaults, "bwlimit"),
+			Compress:     configString(defaults, "compress"),
+			IONice:       configInt64(defaults, "ionice"),
+			MailTo:       configString(defaults, "mailto"),
+			MaxFiles:     configInt64(defaults, "maxfiles"),
+			Mode:         configString(defaults, "mode"),
+			PruneBackups: parsePruneBackups(defaults["prune-backups"]),
+			Storage:      configString(defaults, "storage"),
+		},
+		Filter: config.Filter,
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// parsePruneBackups parses a prune-backups retention policy, which the API returns either as a property string
+// (eg: 'keep-daily=7,keep-last=3') or as an object depending on the version, returning nil if it is unset.
+func parsePruneBackups(value any) *pruneBackupsModel {
+	var policy map[string]any
+	switch v := value.(type) {
+	case map[string]any:
+		policy = v
+	case string:
+		policy = map[string]any{}
+		for key, val := range parsePropertyString(v, "") {
+			policy[key] = val
+		}
+	}
+	if len(policy) == 0 {
+		return nil
+	}
+	return &pruneBackupsModel{
+		KeepAll:     configBool(policy, "keep-all", types.BoolValue(false)),
+		KeepDaily:   configInt64(policy, "keep-daily"),
+		KeepHourly:  configInt64(policy, "keep-hourly"),
+		KeepLast:    configInt64(policy, "keep-last"),
+		KeepMonthly: configInt64(policy, "keep-monthly"),
+		KeepWeekly:  configInt64(policy, "keep-weekly"),
+		KeepYearly:  configInt64(policy, "keep-yearly"),
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &normalizeTagsFunction{}
+)
+
+func NewNormalizeTagsFunction() function.Function {
+	return &normalizeTagsFunction{}
+}
+
+type normalizeTagsFunction struct{}
+
+func (f *normalizeTagsFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "normalize_tags"
+}
+
+func (f *normalizeTagsFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a Proxmox VE tag string",
+		Description: "Splits a raw tag string on commas, semicolons and whitespace the same way Proxmox VE does " +
+			"and returns the tags lower-cased, sorted and without duplicates (eg: 'Web;db, web' becomes " +
+			"['db', 'web']).",
+		MarkdownDescription: "Splits a raw tag string on commas, semicolons and whitespace the same way Proxmox VE " +
+			"does and returns the tags lower-cased, sorted and without duplicates (eg: `Web;db, web` becomes " +
+			"`[\"db\", \"web\"]`).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "tags",
+				Description: "Raw tag string (eg: web;prod)",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *normalizeTagsFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var tags string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalizeTags(tags)))
+}
+
+// normalizeTags splits a raw tag string on the delimiters accepted by Proxmox VE and returns the tags
+// lower-cased, sorted and de-duplicated.
+func normalizeTags(tags string) []string {
+	normalized := []string{}
+	for _, tag := range strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t' || r == '\n'
+	}) {
+		normalized = append(normalized, strings.ToLower(tag))
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
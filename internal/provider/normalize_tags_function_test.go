@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		tags string
+		want []string
+	}{
+		{tags: "web;prod", want: []string{"prod", "web"}},
+		{tags: "Web;db, web", want: []string{"db", "web"}},
+		{tags: " PROD ,web;;Db\tweb\nprod ", want: []string{"db", "prod", "web"}},
+		{tags: "a,b;c d", want: []string{"a", "b", "c", "d"}},
+		{tags: "", want: []string{}},
+		{tags: " ;, ", want: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.tags, func(t *testing.T) {
+			if got := normalizeTags(test.tags); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("normalizeTags(%q) = %v, want %v", test.tags, got, test.want)
+			}
+		})
+	}
+}
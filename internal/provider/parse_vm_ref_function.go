@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	minVMID = 100
+	maxVMID = 999999999
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &parseVMRefFunction{}
+)
+
+func NewParseVMRefFunction() function.Function {
+	return &parseVMRefFunction{}
+}
+
+type parseVMRefFunction struct{}
+
+// vmRef identifies a VM by the node hosting it and its ID.
+type vmRef struct {
+	Node string `tfsdk:"node"`
+	VMID int64  `tfsdk:"vm_id"`
+}
+
+func (f *parseVMRefFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "parse_vm_ref"
+}
+
+func (f *parseVMRefFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Parses a VM reference into its node and VM ID",
+		Description: "Parses a VM reference in the form 'node/vmid' or 'vmid@node' and returns an object with " +
+			"the node and vm_id attributes.",
+		MarkdownDescription: "Parses a VM reference in the form `node/vmid` or `vmid@node` and returns an object " +
+			"with the `node` and `vm_id` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ref",
+				Description: "VM reference to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"node":  types.StringType,
+				"vm_id": types.Int64Type,
+			},
+		},
+	}
+}
+
+func (f *parseVMRefFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var ref string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ref))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := parseVMRef(ref)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseVMRef parses a VM reference in the form 'node/vmid' or 'vmid@node'.
+func parseVMRef(ref string) (vmRef, error) {
+	var node, id string
+	if before, after, found := strings.Cut(ref, "/"); found {
+		node, id = before, after
+	} else if before, after, found := strings.Cut(ref, "@"); found {
+		id, node = before, after
+	} else {
+		return vmRef{}, fmt.Errorf("the VM reference '%s' must be in the form 'node/vmid' or 'vmid@node'", ref)
+	}
+
+	node = strings.TrimSpace(node)
+	if node == "" || strings.ContainsAny(node, "/@ ") {
+		return vmRef{}, fmt.Errorf("the VM reference '%s' does not contain a valid node name", ref)
+	}
+	vmID, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+	if err != nil || vmID < minVMID || vmID > maxVMID {
+		return vmRef{}, fmt.Errorf("the VM reference '%s' does not contain a valid VM ID (%d-%d)", ref,
+			minVMID, maxVMID)
+	}
+	return vmRef{Node: node, VMID: vmID}, nil
+}
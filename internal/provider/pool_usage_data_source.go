@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &poolUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &poolUsageDataSource{}
+)
+
+func NewPoolUsageDataSource() datasource.DataSource {
+	return &poolUsageDataSource{}
+}
+
+type poolUsageDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type poolUsageDataSourceModel struct {
+	Data   []poolUsageDataSourcePoolModel  `tfsdk:"data"`
+	Filter *poolUsageDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type poolUsageDataSourceFilterModel struct {
+	PoolID types.String `tfsdk:"pool_id"`
+}
+
+type poolUsageDataSourcePoolModel struct {
+	Comment        types.String   `tfsdk:"comment"`
+	ContainerCount types.Int64    `tfsdk:"container_count"`
+	DiskSize       types.Int64    `tfsdk:"disk_size"`
+	Memory         types.Int64    `tfsdk:"memory"`
+	Nodes          []types.String `tfsdk:"nodes"`
+	PoolID         types.String   `tfsdk:"pool_id"`
+	VCPUs          types.Int64    `tfsdk:"vcpus"`
+	VMCount        types.Int64    `tfsdk:"vm_count"`
+}
+
+func (d *poolUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *poolUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_pool_usage"
+}
+
+func (d *poolUsageDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Summarizes the resources allocated to the guests of each resource pool, sorted by pool ID, " +
+			"for chargeback and showback. Only the pools and guests the user has the Pool.Audit and VM.Audit " +
+			"privileges on are included.",
+		MarkdownDescription: "Summarizes the resources allocated to the guests of each resource pool, sorted by " +
+			"pool ID, for chargeback and showback. Only the pools and guests the user has the `Pool.Audit` and " +
+			"`VM.Audit` privileges on are included.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Computed: true,
+						},
+						"container_count": schema.Int64Attribute{
+							Description:         "Number of LXC containers in the pool",
+							MarkdownDescription: "Number of LXC containers in the pool",
+							Computed:            true,
+						},
+						"disk_size": schema.Int64Attribute{
+							Description: "Total disk size in bytes; only the boot disk is counted for QEMU VMs and " +
+								"the root disk for LXC containers",
+							MarkdownDescription: "Total disk size in bytes; only the boot disk is counted for QEMU " +
+								"VMs and the root disk for LXC containers",
+							Computed: true,
+						},
+						"memory": schema.Int64Attribute{
+							Description:         "Total memory allocated to the guests in bytes",
+							MarkdownDescription: "Total memory allocated to the guests in bytes",
+							Computed:            true,
+						},
+						"nodes": schema.ListAttribute{
+							Description:         "Sorted names of the nodes hosting the pool's guests",
+							MarkdownDescription: "Sorted names of the nodes hosting the pool's guests",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"pool_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"vcpus": schema.Int64Attribute{
+							Description:         "Total number of virtual CPUs allocated to the guests",
+							MarkdownDescription: "Total number of virtual CPUs allocated to the guests",
+							Computed:            true,
+						},
+						"vm_count": schema.Int64Attribute{
+							Description:         "Number of QEMU VMs (including templates) in the pool",
+							MarkdownDescription: "Number of QEMU VMs (including templates) in the pool",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"pool_id": schema.StringAttribute{
+						Description:         "Only summarize this pool; all pools are summarized when omitted",
+						MarkdownDescription: "Only summarize this pool; all pools are summarized when omitted",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *poolUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config poolUsageDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	poolID := ""
+	if config.Filter != nil {
+		poolID = strings.TrimSpace(config.Filter.PoolID.ValueString())
+	}
+
+	// query for the pools and the guests; the pool membership of each guest is part of its cluster resource
+	var pools proxmox.Pools
+	if err := d.providerData.client.Get(ctx, "/pools", &pools); err != nil {
+		tflog.Error(ctx, "failed to retrieve pools", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Pools",
+			fmt.Sprintf("Failed to retrieve the resource pools:\n\t%s", err.Error()),
+		)
+		return
+	}
+	resources, err := d.providerData.clusterVMResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// aggregate the guests of each pool
+	slices.SortFunc(pools, func(a, b *proxmox.Pool) int {
+		return cmp.Compare(a.PoolID, b.PoolID)
+	})
+	state := poolUsageDataSourceModel{
+		Data:   []poolUsageDataSourcePoolModel{},
+		Filter: config.Filter,
+	}
+	for _, pool := range pools {
+		if poolID != "" && pool.PoolID != poolID {
+			continue
+		}
+		state.Data = append(state.Data, summarizePoolUsage(pool, resources))
+	}
+	if poolID != "" && len(state.Data) == 0 {
+		resp.Diagnostics.AddError(
+			"Pool Not Found",
+			fmt.Sprintf("No resource pool with the ID '%s' exists in the cluster.", poolID),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// summarizePoolUsage totals the resources allocated to the guests in the given cluster resources which are
+// members of the given pool.
+func summarizePoolUsage(pool *proxmox.Pool, resources proxmox.ClusterResources) poolUsageDataSourcePoolModel {
+	var containers, vms int64
+	var diskSize, memory, vcpus uint64
+	nodes := []string{}
+	for _, res := range resources {
+		if res.Pool != pool.PoolID {
+			continue
+		}
+		switch res.Type {
+		case guestTypeQEMU:
+			vms++
+		case guestTypeLXC:
+			containers++
+		default:
+			continue
+		}
+		diskSize += res.MaxDisk
+		memory += res.MaxMem
+		vcpus += res.MaxCPU
+		if !slices.Contains(nodes, res.Node) {
+			nodes = append(nodes, res.Node)
+		}
+	}
+	slices.Sort(nodes)
+
+	summary := poolUsageDataSourcePoolModel{
+		Comment:        types.StringValue(pool.Comment),
+		ContainerCount: types.Int64Value(containers),
+		DiskSize:       uint64Value(diskSize),
+		Memory:         uint64Value(memory),
+		Nodes:          []types.String{},
+		PoolID:         types.StringValue(pool.PoolID),
+		VCPUs:          uint64Value(vcpus),
+		VMCount:        types.Int64Value(vms),
+	}
+	for _, node := range nodes {
+		summary.Nodes = append(summary.Nodes, types.StringValue(node))
+	}
+	return summary
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPoolUsageRead(t *testing.T) {
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api2/json/pools":
+			// the member 105 has been removed from the cluster and is no longer part of its resources
+			writeTestData(t, w, []map[string]any{
+				{"poolid": "web", "comment": "Web tier", "members": []map[string]any{
+					{"id": "qemu/100", "vmid": 100}, {"id": "lxc/101", "vmid": 101},
+					{"id": "qemu/102", "vmid": 102}, {"id": "qemu/105", "vmid": 105},
+				}},
+				{"poolid": "empty"},
+			})
+		case "/api2/json/cluster/resources":
+			writeTestData(t, w, []map[string]any{
+				{"id": "qemu/100", "type": "qemu", "vmid": 100, "node": "pve2", "pool": "web", "maxcpu": 4,
+					"maxmem": 4 << 30, "maxdisk": 32 << 30},
+				{"id": "lxc/101", "type": "lxc", "vmid": 101, "node": "pve1", "pool": "web", "maxcpu": 2,
+					"maxmem": 1 << 30, "maxdisk": 8 << 30},
+				{"id": "qemu/102", "type": "qemu", "vmid": 102, "node": "pve1", "pool": "web", "maxcpu": 1,
+					"maxmem": 2 << 30, "maxdisk": 16 << 30},
+				{"id": "qemu/103", "type": "qemu", "vmid": 103, "node": "pve3", "maxcpu": 8, "maxmem": 8 << 30},
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		poolID string
+		want   []poolUsageDataSourcePoolModel
+	}{
+		{
+			name:   "pool spanning two nodes",
+			poolID: "web",
+			want: []poolUsageDataSourcePoolModel{{
+				Comment:        types.StringValue("Web tier"),
+				ContainerCount: types.Int64Value(1),
+				DiskSize:       types.Int64Value(56 << 30),
+				Memory:         types.Int64Value(7 << 30),
+				Nodes:          []types.String{types.StringValue("pve1"), types.StringValue("pve2")},
+				PoolID:         types.StringValue("web"),
+				VCPUs:          types.Int64Value(7),
+				VMCount:        types.Int64Value(2),
+			}},
+		},
+		{
+			name:   "empty pool",
+			poolID: "empty",
+			want: []poolUsageDataSourcePoolModel{{
+				Comment:        types.StringValue(""),
+				ContainerCount: types.Int64Value(0),
+				DiskSize:       types.Int64Value(0),
+				Memory:         types.Int64Value(0),
+				Nodes:          []types.String{},
+				PoolID:         types.StringValue("empty"),
+				VCPUs:          types.Int64Value(0),
+				VMCount:        types.Int64Value(0),
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter := poolUsageDataSourceFilterModel{PoolID: types.StringValue(test.poolID)}
+			var state poolUsageDataSourceModel
+			diags := readTestDataSource(t, &poolUsageDataSource{providerData: data},
+				poolUsageDataSourceModel{Filter: &filter}, &state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(state.Data, test.want) {
+				t.Errorf("data = %+v, want %+v", state.Data, test.want)
+			}
+		})
+	}
+}
+
+func TestPoolUsageReadUnknownPool(t *testing.T) {
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api2/json/pools":
+			writeTestData(t, w, []map[string]any{{"poolid": "web"}})
+		case "/api2/json/cluster/resources":
+			writeTestData(t, w, []map[string]any{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+	filter := poolUsageDataSourceFilterModel{PoolID: types.StringValue("db")}
+	var state poolUsageDataSourceModel
+	diags := readTestDataSource(t, &poolUsageDataSource{providerData: data}, poolUsageDataSourceModel{Filter: &filter},
+		&state)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Pool Not Found" {
+		t.Errorf("diagnostics = %v, want a pool not found error", diags)
+	}
+}
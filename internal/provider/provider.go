@@ -6,10 +6,13 @@ package provider
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -22,8 +25,9 @@ import (
 
 // Ensure proxmoxveProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider              = &proxmoxveProvider{}
-	_ provider.ProviderWithFunctions = &proxmoxveProvider{}
+	_ provider.Provider                     = &proxmoxveProvider{}
+	_ provider.ProviderWithConfigValidators = &proxmoxveProvider{}
+	_ provider.ProviderWithFunctions        = &proxmoxveProvider{}
 )
 
 // proxmoxveProvider defines the provider implementation.
@@ -35,9 +39,14 @@ type proxmoxveProvider struct {
 }
 
 type proxmoxveProviderData struct {
-	client   *proxmox.Client
-	endpoint string
-	provider *proxmoxveProvider
+	client           *proxmox.Client
+	defaultNode      string
+	endpoint         string
+	operationTimeout time.Duration
+	provider         *proxmoxveProvider
+	readTimeout      time.Duration
+	taskPoll         taskPollSettings
+	timeFormat       string
 }
 
 func (p *proxmoxveProviderData) AddLogContext(ctx context.Context) context.Context {
@@ -45,13 +54,44 @@ func (p *proxmoxveProviderData) AddLogContext(ctx context.Context) context.Conte
 	return ctx
 }
 
+// WithReadTimeout returns a context for a data source read which is cancelled once the provider's read timeout
+// has passed. Without a read timeout the context is only cancelled by the returned function.
+func (p *proxmoxveProviderData) WithReadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.readTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, p.readTimeout)
+}
+
+// NodeName returns the node name given in a data source or resource configuration, falling back to the
+// provider's default node when the value is null, unknown or empty.
+func (p *proxmoxveProviderData) NodeName(name types.String) string {
+	if name.IsNull() || name.IsUnknown() || name.ValueString() == "" {
+		return p.defaultNode
+	}
+	return name.ValueString()
+}
+
 // proxmoxveProviderModel describes the provider data model.
 type proxmoxveProviderModel struct {
-	APITokenID                    types.String `tfsdk:"api_token_id"`
-	APITokenSecret                types.String `tfsdk:"api_token_secret"`
-	APITokenUsername              types.String `tfsdk:"api_token_username"`
-	Endpoint                      types.String `tfsdk:"endpoint"`
-	IgnoreUntrustedSSLCertificate types.Bool   `tfsdk:"ignore_untrusted_ssl_certificate"`
+	APITokenID                    types.String  `tfsdk:"api_token_id"`
+	APITokenSecret                types.String  `tfsdk:"api_token_secret"`
+	APITokenUsername              types.String  `tfsdk:"api_token_username"`
+	DefaultNode                   types.String  `tfsdk:"default_node"`
+	Endpoint                      types.String  `tfsdk:"endpoint"`
+	IgnoreUntrustedSSLCertificate types.Bool    `tfsdk:"ignore_untrusted_ssl_certificate"`
+	MaxSupportedVersion           types.String  `tfsdk:"max_supported_version"`
+	MinSupportedVersion           types.String  `tfsdk:"min_supported_version"`
+	OperationTimeout              types.String  `tfsdk:"operation_timeout"`
+	ReadTimeout                   types.String  `tfsdk:"read_timeout"`
+	SkipVersionCheck              types.Bool    `tfsdk:"skip_version_check"`
+	TaskPollMaxInterval           types.String  `tfsdk:"task_poll_max_interval"`
+	TaskPollMinInterval           types.String  `tfsdk:"task_poll_min_interval"`
+	TaskPollMultiplier            types.Float64 `tfsdk:"task_poll_multiplier"`
+	TimeFormat                    types.String  `tfsdk:"time_format"`
+	TLSCipherSuites               types.List    `tfsdk:"tls_cipher_suites"`
+	TLSCurvePreferences           types.List    `tfsdk:"tls_curve_preferences"`
+	TLSFingerprint                types.String  `tfsdk:"tls_fingerprint"`
 }
 
 func (p *proxmoxveProvider) Metadata(ctx context.Context, req provider.MetadataRequest,
@@ -87,6 +127,13 @@ func (p *proxmoxveProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Sensitive:           true,
 				//Validators:          []validator.String{},
 			},
+			"default_node": schema.StringAttribute{
+				Description: "Name of the Proxmox VE node to use when a data source or resource does not specify " +
+					"one; an explicitly configured node name always takes precedence",
+				MarkdownDescription: "Name of the Proxmox VE node to use when a data source or resource does not " +
+					"specify one; an explicitly configured `node_name` always takes precedence",
+				Optional: true,
+			},
 			"endpoint": schema.StringAttribute{
 				Description:         "Proxmox VE base URL endpoint (eg: https://server:port)",
 				MarkdownDescription: "Proxmox VE base URL endpoint (eg: https://server:port)",
@@ -99,10 +146,110 @@ func (p *proxmoxveProvider) Schema(ctx context.Context, req provider.SchemaReque
 				MarkdownDescription: "Ignore any untrusted / self-signed certificate from the Proxmox VE endpoint",
 				Optional:            true,
 			},
+			"max_supported_version": schema.StringAttribute{
+				Description: "Highest Proxmox VE version (eg: 8.2) the configuration is known to work with; a " +
+					"warning is shown when connecting to a newer server",
+				MarkdownDescription: "Highest Proxmox VE version (eg: `8.2`) the configuration is known to work " +
+					"with; a warning is shown when connecting to a newer server",
+				Optional: true,
+			},
+			"min_supported_version": schema.StringAttribute{
+				Description: "Lowest Proxmox VE version (eg: 7.4) the configuration is known to work with; a " +
+					"warning is shown when connecting to an older server",
+				MarkdownDescription: "Lowest Proxmox VE version (eg: `7.4`) the configuration is known to work " +
+					"with; a warning is shown when connecting to an older server",
+				Optional: true,
+			},
+			"operation_timeout": schema.StringAttribute{
+				Description: "Maximum time to wait for a long-running task started by a resource to complete " +
+					"(eg: 30m); tasks are waited for until they finish when omitted",
+				MarkdownDescription: "Maximum time to wait for a long-running task started by a resource to " +
+					"complete (eg: `30m`); tasks are waited for until they finish when omitted",
+				Optional: true,
+			},
+			"read_timeout": schema.StringAttribute{
+				Description: "Maximum time a single data source read may take (eg: 2m), including all of its API " +
+					"requests; data source reads are not limited when omitted",
+				MarkdownDescription: "Maximum time a single data source read may take (eg: `2m`), including all of " +
+					"its API requests; data source reads are not limited when omitted",
+				Optional: true,
+			},
+			"skip_version_check": schema.BoolAttribute{
+				Description: "Skip retrieving the Proxmox VE version when the provider is configured so that the " +
+					"server does not need to be reachable, eg: when planning offline; data source reads and " +
+					"resource changes still fail if it is unreachable. Conflicts with min_supported_version and " +
+					"max_supported_version",
+				MarkdownDescription: "Skip retrieving the Proxmox VE version when the provider is configured so " +
+					"that the server does not need to be reachable, eg: when planning offline; data source reads " +
+					"and resource changes still fail if it is unreachable. Conflicts with `min_supported_version` " +
+					"and `max_supported_version`",
+				Optional: true,
+			},
+			"task_poll_max_interval": schema.StringAttribute{
+				Description: fmt.Sprintf("Maximum interval between polls of a long-running task's status "+
+					"(eg: 10s); defaults to %s", defaultTaskPollMaxInterval),
+				MarkdownDescription: fmt.Sprintf("Maximum interval between polls of a long-running task's status "+
+					"(eg: `10s`); defaults to `%s`", defaultTaskPollMaxInterval),
+				Optional: true,
+			},
+			"task_poll_min_interval": schema.StringAttribute{
+				Description: fmt.Sprintf("Initial interval between polls of a long-running task's status "+
+					"(eg: 500ms); defaults to %s", defaultTaskPollMinInterval),
+				MarkdownDescription: fmt.Sprintf("Initial interval between polls of a long-running task's status "+
+					"(eg: `500ms`); defaults to `%s`", defaultTaskPollMinInterval),
+				Optional: true,
+			},
+			"task_poll_multiplier": schema.Float64Attribute{
+				Description: fmt.Sprintf("Factor by which the interval between polls of a long-running task's "+
+					"status grows after each poll; defaults to %g", defaultTaskPollMultiplier),
+				MarkdownDescription: fmt.Sprintf("Factor by which the interval between polls of a long-running "+
+					"task's status grows after each poll; defaults to `%g`", defaultTaskPollMultiplier),
+				Optional: true,
+			},
+			"time_format": schema.StringAttribute{
+				Description: fmt.Sprintf("Format of the formatted timestamp attributes (eg: time_formatted): %s "+
+					"for Unix timestamps or %s for RFC 3339 date and times in UTC; defaults to %s", timeFormatEpoch,
+					timeFormatRFC3339, timeFormatEpoch),
+				MarkdownDescription: fmt.Sprintf("Format of the formatted timestamp attributes (eg: "+
+					"`time_formatted`): `%s` for Unix timestamps or `%s` for RFC 3339 date and times in UTC; "+
+					"defaults to `%s`", timeFormatEpoch, timeFormatRFC3339, timeFormatEpoch),
+				Optional: true,
+			},
+			"tls_cipher_suites": schema.ListAttribute{
+				Description: "TLS 1.0-1.2 cipher suites allowed when connecting to the endpoint, by their Go name " +
+					"(eg: TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256); defaults to Go's secure cipher suites",
+				MarkdownDescription: "TLS 1.0-1.2 cipher suites allowed when connecting to the endpoint, by their " +
+					"Go name (eg: `TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256`); defaults to Go's secure cipher suites",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tls_curve_preferences": schema.ListAttribute{
+				Description: "Elliptic curves used for TLS key exchange in order of preference (X25519, " +
+					"CurveP256, CurveP384 or CurveP521); defaults to Go's curve preferences",
+				MarkdownDescription: "Elliptic curves used for TLS key exchange in order of preference (`X25519`, " +
+					"`CurveP256`, `CurveP384` or `CurveP521`); defaults to Go's curve preferences",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tls_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of the endpoint's certificate (eg: AB:CD:...:EF); when set, the " +
+					"connection is only accepted if the certificate matches, whether or not it is otherwise " +
+					"trusted; conflicts with ignore_untrusted_ssl_certificate",
+				MarkdownDescription: "SHA-256 fingerprint of the endpoint's certificate (eg: `AB:CD:...:EF`); " +
+					"when set, the connection is only accepted if the certificate matches, whether or not it is " +
+					"otherwise trusted; conflicts with `ignore_untrusted_ssl_certificate`",
+				Optional: true,
+			},
 		},
 	}
 }
 
+func (p *proxmoxveProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		conflictingProviderConfigValidator{conflicts: providerConfigConflicts},
+	}
+}
+
 func (p *proxmoxveProvider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	resp *provider.ConfigureResponse) {
 
@@ -142,6 +289,15 @@ func (p *proxmoxveProvider) Configure(ctx context.Context, req provider.Configur
 				"statically in the configuration, or use a variable in the configuration.",
 		)
 	}
+	if config.DefaultNode.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_node"),
+			"Unknown Proxmox VE Default Node",
+			"The provider cannot create the Proxmox VE API client as there is an unknown configuration value for "+
+				"the default node. Either target apply the source of the value first, set the value "+
+				"statically in the configuration, or use a variable in the configuration.",
+		)
+	}
 	if config.Endpoint.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
@@ -196,6 +352,145 @@ func (p *proxmoxveProvider) Configure(ctx context.Context, req provider.Configur
 				"statically in the configuration, or use a variable in the configuration.",
 		)
 	}
+	supportedVersions := map[string]*pveVersion{}
+	for name, value := range map[string]types.String{
+		"max_supported_version": config.MaxSupportedVersion,
+		"min_supported_version": config.MinSupportedVersion,
+	} {
+		if value.ValueString() == "" {
+			continue
+		}
+		version, err := parsePVEVersion(value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Supported Version",
+				fmt.Sprintf("The supported version is invalid: %s.", err.Error()),
+			)
+			continue
+		}
+		supportedVersions[name] = &version
+	}
+	var operationTimeout time.Duration
+	if value := config.OperationTimeout.ValueString(); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("operation_timeout"),
+				"Invalid Operation Timeout",
+				fmt.Sprintf("The operation timeout '%s' must be a positive duration (eg: 30m).", value),
+			)
+		}
+		operationTimeout = timeout
+	}
+	var readTimeout time.Duration
+	if value := config.ReadTimeout.ValueString(); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_timeout"),
+				"Invalid Read Timeout",
+				fmt.Sprintf("The read timeout '%s' must be a positive duration (eg: 2m).", value),
+			)
+		}
+		readTimeout = timeout
+	}
+	taskPoll := taskPollSettings{
+		minInterval: defaultTaskPollMinInterval,
+		maxInterval: defaultTaskPollMaxInterval,
+		multiplier:  defaultTaskPollMultiplier,
+	}
+	if value := config.TaskPollMinInterval.ValueString(); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("task_poll_min_interval"),
+				"Invalid Task Poll Minimum Interval",
+				fmt.Sprintf("The task poll minimum interval '%s' must be a positive duration (eg: 500ms).", value),
+			)
+		}
+		taskPoll.minInterval = interval
+	}
+	if value := config.TaskPollMaxInterval.ValueString(); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("task_poll_max_interval"),
+				"Invalid Task Poll Maximum Interval",
+				fmt.Sprintf("The task poll maximum interval '%s' must be a positive duration (eg: 10s).", value),
+			)
+		}
+		taskPoll.maxInterval = interval
+	}
+	if taskPoll.maxInterval < taskPoll.minInterval {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("task_poll_max_interval"),
+			"Invalid Task Poll Maximum Interval",
+			fmt.Sprintf("The task poll maximum interval (%s) must not be less than the minimum interval (%s).",
+				taskPoll.maxInterval, taskPoll.minInterval),
+		)
+	}
+	if !config.TaskPollMultiplier.IsNull() {
+		taskPoll.multiplier = config.TaskPollMultiplier.ValueFloat64()
+		if taskPoll.multiplier < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("task_poll_multiplier"),
+				"Invalid Task Poll Multiplier",
+				fmt.Sprintf("The task poll multiplier (%g) must be greater than or equal to 1.", taskPoll.multiplier),
+			)
+		}
+	}
+	timeFormat, err := parseTimeFormat(config.TimeFormat.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("time_format"),
+			"Invalid Time Format",
+			fmt.Sprintf("The time format is invalid: %s.", err.Error()),
+		)
+	}
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.IgnoreUntrustedSSLCertificate.ValueBool(),
+	}
+	if !config.TLSCipherSuites.IsNull() && !config.TLSCipherSuites.IsUnknown() {
+		var names []string
+		resp.Diagnostics.Append(config.TLSCipherSuites.ElementsAs(ctx, &names, false)...)
+		suites, err := parseTLSCipherSuites(names)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tls_cipher_suites"),
+				"Invalid TLS Cipher Suites",
+				fmt.Sprintf("The TLS cipher suites are invalid: %s.", err.Error()),
+			)
+		}
+		tlsConfig.CipherSuites = suites
+	}
+	if !config.TLSCurvePreferences.IsNull() && !config.TLSCurvePreferences.IsUnknown() {
+		var names []string
+		resp.Diagnostics.Append(config.TLSCurvePreferences.ElementsAs(ctx, &names, false)...)
+		curves, err := parseTLSCurvePreferences(names)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tls_curve_preferences"),
+				"Invalid TLS Curve Preferences",
+				fmt.Sprintf("The TLS curve preferences are invalid: %s.", err.Error()),
+			)
+		}
+		tlsConfig.CurvePreferences = curves
+	}
+	if value := config.TLSFingerprint.ValueString(); value != "" {
+		fingerprint, err := parseTLSFingerprint(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tls_fingerprint"),
+				"Invalid TLS Fingerprint",
+				fmt.Sprintf("The TLS fingerprint is invalid: %s.", err.Error()),
+			)
+		}
+
+		// the pinned fingerprint replaces the usual chain verification
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = verifyTLSFingerprint(fingerprint)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -203,23 +498,56 @@ func (p *proxmoxveProvider) Configure(ctx context.Context, req provider.Configur
 	// create the API client
 	httpClient := http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: config.IgnoreUntrustedSSLCertificate.ValueBool(),
-			},
+			TLSClientConfig: tlsConfig,
 		},
 	}
 	client := proxmox.NewClient(
 		fmt.Sprintf("%s/api2/json", endpoint),
 		proxmox.WithHTTPClient(&httpClient),
 		proxmox.WithAPIToken(fmt.Sprintf("%s!%s", apiTokenUsername, apiTokenID), apiTokenSecret))
-	version, err := client.Version(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
+	if config.SkipVersionCheck.ValueBool() {
+		tflog.Info(ctx, "skipping Proxmox VE version check", map[string]any{"endpoint": endpoint})
+	} else {
+		checkServerVersion(ctx, client, endpoint, taskPoll, supportedVersions, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.DataSourceData = &proxmoxveProviderData{
+		client:           client,
+		defaultNode:      config.DefaultNode.ValueString(),
+		endpoint:         endpoint,
+		operationTimeout: operationTimeout,
+		provider:         p,
+		readTimeout:      readTimeout,
+		taskPoll:         taskPoll,
+		timeFormat:       timeFormat,
+	}
+	resp.ResourceData = resp.DataSourceData
+}
+
+// checkServerVersion retrieves the version of the Proxmox VE server, which also verifies that the endpoint is
+// reachable with the configured credentials and certificate, and warns if it falls outside of the supported range.
+func checkServerVersion(ctx context.Context, client *proxmox.Client, endpoint string, taskPoll taskPollSettings,
+	supportedVersions map[string]*pveVersion, diags *diag.Diagnostics) {
+
+	version, err := versionWithRetry(ctx, client, taskPoll)
+	var mismatch *tlsFingerprintMismatchError
+	if errors.As(err, &mismatch) {
+		diags.AddAttributeError(
+			path.Root("tls_fingerprint"),
+			"TLS Fingerprint Mismatch",
+			fmt.Sprintf("The certificate presented by the Proxmox VE endpoint does not match the pinned "+
+				"fingerprint.\n\tExpected: %s\n\tActual:   %s", formatTLSFingerprint(mismatch.expected),
+				formatTLSFingerprint(mismatch.actual)),
+		)
+	} else if err != nil {
+		diags.AddError(
 			"Proxmox VE API: Get Version Failed",
 			fmt.Sprintf("Failed to get the Proxmox VE version details from the API:\n\t%s", err.Error()),
 		)
 	}
-	if resp.Diagnostics.HasError() {
+	if diags.HasError() {
 		return
 	}
 	tflog.Info(ctx, "connected to Proxmox VE server", map[string]any{
@@ -228,26 +556,85 @@ func (p *proxmoxveProvider) Configure(ctx context.Context, req provider.Configur
 		"repo_id":  version.RepoID,
 		"endpoint": endpoint,
 	})
-	resp.DataSourceData = &proxmoxveProviderData{
-		client:   client,
-		endpoint: endpoint,
-		provider: p,
-	}
-	resp.ResourceData = resp.DataSourceData
+	checkSupportedVersion(version.Version, supportedVersions["min_supported_version"],
+		supportedVersions["max_supported_version"], diags)
 }
 
 func (p *proxmoxveProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewDownloadFileResource,
+		NewFirewallIPSetResource,
+		NewMetricsServerResource,
+		NewNodeCertificateOrderResource,
+		NewNodePowerResource,
+		NewRealmResource,
+		NewSDNApplyResource,
+		NewUserGroupMembershipResource,
+		NewVMDisplayResource,
+		NewVMMigrationResource,
+		NewVMNICLinkResource,
+		NewVMStartupResource,
+		NewVMTagsResource,
+	}
 }
 
 func (p *proxmoxveProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccessPermissionsDataSource,
+		NewApplianceTemplatesDataSource,
+		NewBackupJobStatusDataSource,
+		NewClusterJoinInfoDataSource,
+		NewClusterLogDataSource,
+		NewClusterMACPrefixDataSource,
+		NewClusterMasterDataSource,
+		NewClusterOptionsDataSource,
+		NewFirewallAliasesDataSource,
+		NewFirewallIPSetsDataSource,
+		NewFreeVMIDsDataSource,
+		NewHAStatusDataSource,
+		NewLXCConfigDataSource,
+		NewLXCStatusDataSource,
+		NewMACLookupDataSource,
+		NewMetricsServersDataSource,
+		NewNodeBridgeUsageDataSource,
+		NewNodeCapabilitiesDataSource,
+		NewNodeFirewallOptionsDataSource,
+		NewNodeHardwareDataSource,
+		NewNodeKSMDataSource,
+		NewNodeRepositoriesDataSource,
+		NewNodeSyslogDataSource,
+		NewNodeVzdumpDefaultsDataSource,
+		NewPoolUsageDataSource,
+		NewRealmsDataSource,
+		NewStorageCapabilitiesDataSource,
+		NewStorageDataSource,
+		NewVMAddressesDataSource,
+		NewVMAgentFSInfoDataSource,
+		NewVMAgentInfoDataSource,
 		NewVMConfigDataSource,
+		NewVMConfigValueDataSource,
+		NewVMLocationDataSource,
+		NewVMSPICEInfoDataSource,
+		NewVMStatusDataSource,
+		NewVMTaskLogDataSource,
+		NewVMsDataSource,
 	}
 }
 
 func (p *proxmoxveProvider) Functions(ctx context.Context) []func() function.Function {
-	return nil
+	return []func() function.Function{
+		NewBuildDiskConfigFunction,
+		NewBuildIPConfigFunction,
+		NewDecodeDescriptionFunction,
+		NewDiffNetConfigFunction,
+		NewEncodeDescriptionFunction,
+		NewForecastUsageFunction,
+		NewNormalizeTagsFunction,
+		NewParseVMRefFunction,
+		NewSanitizeHostnameFunction,
+		NewTagsEqualFunction,
+		NewValidateCIDRFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
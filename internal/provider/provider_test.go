@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderDataNodeName(t *testing.T) {
+	tests := []struct {
+		name        string
+		defaultNode string
+		value       types.String
+		want        string
+	}{
+		{name: "explicit wins over default", defaultNode: "pve1", value: types.StringValue("pve2"), want: "pve2"},
+		{name: "null falls back", defaultNode: "pve1", value: types.StringNull(), want: "pve1"},
+		{name: "unknown falls back", defaultNode: "pve1", value: types.StringUnknown(), want: "pve1"},
+		{name: "empty falls back", defaultNode: "pve1", value: types.StringValue(""), want: "pve1"},
+		{name: "explicit without default", value: types.StringValue("pve2"), want: "pve2"},
+		{name: "null without default", value: types.StringNull(), want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &proxmoxveProviderData{defaultNode: test.defaultNode}
+			if got := data.NodeName(test.value); got != test.want {
+				t.Errorf("NodeName(%v) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestProviderDataReadTimeout(t *testing.T) {
+	// the handler only answers once the client gives up so that the read can only finish through the timeout
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+			writeTestData(t, w, map[string]any{})
+		}
+	})
+	data.readTimeout = 100 * time.Millisecond
+	config := lxcConfigDataSourceModel{
+		Filter: &lxcConfigDataSourceFilterModel{NodeName: types.StringValue("pve1"), VMID: types.Int32Value(200)},
+	}
+	var state lxcConfigDataSourceModel
+	start := time.Now()
+	diags := readTestDataSource(t, &lxcConfigDataSource{providerData: data}, config, &state)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("read took %s, want it to stop after the read timeout", elapsed)
+	}
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), context.DeadlineExceeded.Error()) {
+		t.Errorf("diagnostics = %v, want a deadline exceeded error", diags)
+	}
+}
+
+func TestResourceImportStatePassthrough(t *testing.T) {
+	tests := []struct {
+		name      string
+		resource  resource.ResourceWithImportState
+		id        string
+		attribute string
+	}{
+		{name: "firewall ipset", resource: &firewallIPSetResource{}, id: "trusted", attribute: "name"},
+		{name: "metrics server", resource: &metricsServerResource{}, id: "influx", attribute: "id"},
+		{name: "node certificate order", resource: &nodeCertificateOrderResource{}, id: "pve1", attribute: "node_name"},
+		{name: "realm", resource: &realmResource{}, id: "ldap", attribute: "realm"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			var schemaResp resource.SchemaResponse
+			test.resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			resp := resource.ImportStateResponse{State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+			test.resource.ImportState(ctx, resource.ImportStateRequest{ID: test.id}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			var got types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(test.attribute), &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("failed to read the imported state: %v", resp.Diagnostics)
+			}
+			if got != types.StringValue(test.id) {
+				t.Errorf("%s = %v, want %q", test.attribute, got, test.id)
+			}
+		})
+	}
+}
+
+// readTestDataSource runs the Read of the given data source with the given configuration model and stores the
+// resulting state in the given model, returning the diagnostics of the read.
+func readTestDataSource(t *testing.T, ds datasource.DataSource, config, state any) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp datasource.SchemaResponse
+	ds.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	// the configuration is built through a state since only a state can be set from a model
+	configState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)}
+	if diags := configState.Set(ctx, config); diags.HasError() {
+		t.Fatalf("failed to build the configuration: %v", diags)
+	}
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+	}
+	ds.Read(ctx, req, &resp)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, state)...)
+	}
+	return resp.Diagnostics
+}
+
+// importTestResource imports the given resource with the given ID the way Terraform does, by running its
+// ImportState followed by its Read, and returns the resulting state along with the diagnostics of both.
+func importTestResource(t *testing.T, r resource.ResourceWithImportState, id string) (tfsdk.State,
+	diag.Diagnostics) {
+
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	emptyState := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	importResp := resource.ImportStateResponse{State: emptyState}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		return importResp.State, importResp.Diagnostics
+	}
+	readResp := resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	return readResp.State, append(importResp.Diagnostics, readResp.Diagnostics...)
+}
+
+// readTestResource runs the Read of the given resource with the given state model and returns the resulting
+// state along with the diagnostics of the read.
+func readTestResource(t *testing.T, r resource.Resource, state any) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	priorState := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := priorState.Set(ctx, state); diags.HasError() {
+		t.Fatalf("failed to build the prior state: %v", diags)
+	}
+	resp := resource.ReadResponse{State: priorState}
+	r.Read(ctx, resource.ReadRequest{State: priorState}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+// updateTestResource runs the Update of the given resource to move it from the given state model to the given
+// plan model and returns the diagnostics of the update.
+func updateTestResource(t *testing.T, r resource.Resource, state, plan any) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	priorState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)}
+	plannedState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)}
+	if diags := priorState.Set(ctx, state); diags.HasError() {
+		t.Fatalf("failed to build the prior state: %v", diags)
+	}
+	if diags := plannedState.Set(ctx, plan); diags.HasError() {
+		t.Fatalf("failed to build the plan: %v", diags)
+	}
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plannedState.Raw},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: plannedState.Raw},
+		State:  priorState,
+	}
+	resp := resource.UpdateResponse{State: priorState}
+	r.Update(ctx, req, &resp)
+	return resp.Diagnostics
+}
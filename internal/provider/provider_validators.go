@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// providerConfigConflict describes a pair of provider attributes which cannot be used together.
+type providerConfigConflict struct {
+	attributes [2]string
+	reason     string
+
+	// conflicts reports whether the configuration sets both attributes; it must return false if either value
+	// is unknown so that the conflict is only reported once the values are known
+	conflicts func(config proxmoxveProviderModel) bool
+}
+
+// providerConfigConflicts lists the incompatible combinations of provider attributes.
+var providerConfigConflicts = []providerConfigConflict{
+	{
+		attributes: [2]string{"ignore_untrusted_ssl_certificate", "tls_fingerprint"},
+		reason: "a pinned fingerprint only accepts the matching certificate, whereas ignoring untrusted " +
+			"certificates accepts any certificate",
+		conflicts: func(config proxmoxveProviderModel) bool {
+			return config.IgnoreUntrustedSSLCertificate.ValueBool() && !config.TLSFingerprint.IsUnknown() &&
+				strings.TrimSpace(config.TLSFingerprint.ValueString()) != ""
+		},
+	},
+	{
+		attributes: [2]string{"skip_version_check", "min_supported_version"},
+		reason:     "the server version is only known when it is checked",
+		conflicts: func(config proxmoxveProviderModel) bool {
+			return config.SkipVersionCheck.ValueBool() && !config.MinSupportedVersion.IsUnknown() &&
+				strings.TrimSpace(config.MinSupportedVersion.ValueString()) != ""
+		},
+	},
+	{
+		attributes: [2]string{"skip_version_check", "max_supported_version"},
+		reason:     "the server version is only known when it is checked",
+		conflicts: func(config proxmoxveProviderModel) bool {
+			return config.SkipVersionCheck.ValueBool() && !config.MaxSupportedVersion.IsUnknown() &&
+				strings.TrimSpace(config.MaxSupportedVersion.ValueString()) != ""
+		},
+	},
+}
+
+// conflictingProviderConfigValidator reports an error for each combination of conflicting provider attributes
+// which is set.
+type conflictingProviderConfigValidator struct {
+	conflicts []providerConfigConflict
+}
+
+func (v conflictingProviderConfigValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v conflictingProviderConfigValidator) MarkdownDescription(_ context.Context) string {
+	pairs := []string{}
+	for _, conflict := range v.conflicts {
+		pairs = append(pairs, fmt.Sprintf("%s and %s", conflict.attributes[0], conflict.attributes[1]))
+	}
+	return "These attributes cannot be used together: " + strings.Join(pairs, "; ")
+}
+
+func (v conflictingProviderConfigValidator) ValidateProvider(ctx context.Context,
+	req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+
+	var config proxmoxveProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, conflict := range v.conflicts {
+		if !conflict.conflicts(config) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(conflict.attributes[1]),
+			"Conflicting Provider Configuration",
+			fmt.Sprintf("The '%s' and '%s' attributes cannot be used together as %s. Remove one of them.",
+				conflict.attributes[0], conflict.attributes[1], conflict.reason),
+		)
+	}
+}
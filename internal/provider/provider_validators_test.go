@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConflictingProviderConfigValidator(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   func(config *proxmoxveProviderModel)
+		wantPath string
+	}{
+		{
+			name:   "no conflicts",
+			config: func(config *proxmoxveProviderModel) {},
+		},
+		{
+			name: "ignore_untrusted_ssl_certificate and tls_fingerprint",
+			config: func(config *proxmoxveProviderModel) {
+				config.IgnoreUntrustedSSLCertificate = types.BoolValue(true)
+				config.TLSFingerprint = types.StringValue("AB:CD")
+			},
+			wantPath: "tls_fingerprint",
+		},
+		{
+			name: "skip_version_check and min_supported_version",
+			config: func(config *proxmoxveProviderModel) {
+				config.SkipVersionCheck = types.BoolValue(true)
+				config.MinSupportedVersion = types.StringValue("8.0")
+			},
+			wantPath: "min_supported_version",
+		},
+		{
+			name: "skip_version_check and max_supported_version",
+			config: func(config *proxmoxveProviderModel) {
+				config.SkipVersionCheck = types.BoolValue(true)
+				config.MaxSupportedVersion = types.StringValue("8.4")
+			},
+			wantPath: "max_supported_version",
+		},
+		{
+			name: "disabled flag",
+			config: func(config *proxmoxveProviderModel) {
+				config.IgnoreUntrustedSSLCertificate = types.BoolValue(false)
+				config.TLSFingerprint = types.StringValue("AB:CD")
+				config.SkipVersionCheck = types.BoolValue(false)
+				config.MinSupportedVersion = types.StringValue("8.0")
+			},
+		},
+		{
+			name: "unknown value",
+			config: func(config *proxmoxveProviderModel) {
+				config.SkipVersionCheck = types.BoolValue(true)
+				config.MinSupportedVersion = types.StringUnknown()
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			var schemaResp provider.SchemaResponse
+			(&proxmoxveProvider{}).Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+			model := proxmoxveProviderModel{
+				TLSCipherSuites:     types.ListNull(types.StringType),
+				TLSCurvePreferences: types.ListNull(types.StringType),
+			}
+			test.config(&model)
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("failed to build the configuration: %v", diags)
+			}
+
+			req := provider.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}
+			var resp provider.ValidateConfigResponse
+			conflictingProviderConfigValidator{conflicts: providerConfigConflicts}.ValidateProvider(ctx, req, &resp)
+			if test.wantPath == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("diagnostics = %v, want a single error", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.Errors()[0]; got.Summary() != "Conflicting Provider Configuration" {
+				t.Errorf("summary = %q, want Conflicting Provider Configuration", got.Summary())
+			}
+			if got, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok ||
+				!got.Path().Equal(path.Root(test.wantPath)) {
+				t.Errorf("error is not reported on the %s attribute", test.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// pveVersion is a parsed Proxmox VE version. Components which were not given (eg: the patch level of '8.2')
+// are not considered when comparing against another version, so a maximum of '8.2' includes '8.2.4'.
+type pveVersion struct {
+	components []int
+	raw        string
+}
+
+// parsePVEVersion parses a version such as '8', '8.2' or '8.2.4'. Anything following the numeric components
+// (eg: the '-1' of '8.2.4-1' or the '~beta' of '8.3.0~beta') is ignored.
+func parsePVEVersion(value string) (pveVersion, error) {
+	version := pveVersion{raw: strings.TrimSpace(value)}
+	numeric := version.raw
+	if i := strings.IndexFunc(numeric, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }); i >= 0 {
+		numeric = numeric[:i]
+	}
+	parts := strings.Split(numeric, ".")
+	if len(parts) > 3 {
+		parts = parts[:3]
+	}
+	for _, part := range parts {
+		component, err := strconv.Atoi(part)
+		if err != nil {
+			return pveVersion{}, fmt.Errorf("'%s' is not a valid version (eg: 8.2.4)", value)
+		}
+		version.components = append(version.components, component)
+	}
+	return version, nil
+}
+
+// compare compares the version against the given bound using only the components present in the bound,
+// returning -1, 0 or 1 if the version is lower than, within or higher than the bound.
+func (v pveVersion) compare(bound pveVersion) int {
+	for i, component := range bound.components {
+		actual := 0
+		if i < len(v.components) {
+			actual = v.components[i]
+		}
+		if result := cmp.Compare(actual, component); result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+func (v pveVersion) String() string {
+	return v.raw
+}
+
+// checkSupportedVersion adds a warning if the given server version falls outside of the optional supported range.
+func checkSupportedVersion(serverVersion string, minVersion, maxVersion *pveVersion, diags *diag.Diagnostics) {
+	if minVersion == nil && maxVersion == nil {
+		return
+	}
+	version, err := parsePVEVersion(serverVersion)
+	if err != nil {
+		diags.AddWarning(
+			"Unrecognized Proxmox VE Version",
+			fmt.Sprintf("The version reported by the Proxmox VE server could not be compared against the "+
+				"supported range: %s.", err.Error()),
+		)
+		return
+	}
+	if minVersion != nil && version.compare(*minVersion) < 0 {
+		diags.AddWarning(
+			"Unsupported Proxmox VE Version",
+			fmt.Sprintf("The Proxmox VE server is running version %s, which is older than the minimum supported "+
+				"version %s. Some resources and data sources may not work as expected.", version, minVersion),
+		)
+	}
+	if maxVersion != nil && version.compare(*maxVersion) > 0 {
+		diags.AddWarning(
+			"Unsupported Proxmox VE Version",
+			fmt.Sprintf("The Proxmox VE server is running version %s, which is newer than the maximum supported "+
+				"version %s. Some resources and data sources may not work as expected.", version, maxVersion),
+		)
+	}
+}
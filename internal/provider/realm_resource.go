@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &realmResource{}
+	_ resource.ResourceWithConfigure      = &realmResource{}
+	_ resource.ResourceWithImportState    = &realmResource{}
+	_ resource.ResourceWithValidateConfig = &realmResource{}
+)
+
+func NewRealmResource() resource.Resource {
+	return &realmResource{}
+}
+
+type realmResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type realmResourceModel struct {
+	BaseDN       types.String `tfsdk:"base_dn"`
+	BindDN       types.String `tfsdk:"bind_dn"`
+	Comment      types.String `tfsdk:"comment"`
+	Default      types.Bool   `tfsdk:"default"`
+	Domain       types.String `tfsdk:"domain"`
+	Mode         types.String `tfsdk:"mode"`
+	Password     types.String `tfsdk:"password"`
+	Port         types.Int64  `tfsdk:"port"`
+	Realm        types.String `tfsdk:"realm"`
+	Secure       types.Bool   `tfsdk:"secure"`
+	Server1      types.String `tfsdk:"server1"`
+	Server2      types.String `tfsdk:"server2"`
+	SyncDefaults types.String `tfsdk:"sync_defaults"`
+	Type         types.String `tfsdk:"type"`
+	UserAttr     types.String `tfsdk:"user_attr"`
+	Verify       types.Bool   `tfsdk:"verify"`
+}
+
+func (r *realmResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *realmResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_realm"
+}
+
+func (r *realmResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an LDAP or Active Directory authentication realm. Existing realms are imported " +
+			"using their realm ID.",
+		MarkdownDescription: "Manages an LDAP or Active Directory authentication realm. Existing realms are " +
+			"imported using their `realm` ID.",
+		Attributes: map[string]schema.Attribute{
+			"base_dn": schema.StringAttribute{
+				Description:         "LDAP base domain name (required for LDAP realms)",
+				MarkdownDescription: "LDAP base domain name (required for `ldap` realms)",
+				Optional:            true,
+			},
+			"bind_dn": schema.StringAttribute{
+				Description:         "LDAP bind domain name",
+				MarkdownDescription: "LDAP bind domain name",
+				Optional:            true,
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"default": schema.BoolAttribute{
+				Description:         "Use this realm as the default for logins",
+				MarkdownDescription: "Use this realm as the default for logins",
+				Optional:            true,
+			},
+			"domain": schema.StringAttribute{
+				Description:         "Active Directory domain (required for AD realms)",
+				MarkdownDescription: "Active Directory domain (required for `ad` realms)",
+				Optional:            true,
+			},
+			"mode": schema.StringAttribute{
+				Description:         "Connection protocol: ldap, ldaps or ldap+starttls",
+				MarkdownDescription: "Connection protocol: `ldap`, `ldaps` or `ldap+starttls`",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				Description:         "Password of the bind user; never read back from the server",
+				MarkdownDescription: "Password of the bind user; never read back from the server",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+			},
+			"realm": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secure": schema.BoolAttribute{
+				Description:         "Use a secure LDAPS connection (deprecated by the server in favor of mode)",
+				MarkdownDescription: "Use a secure LDAPS connection (deprecated by the server in favor of `mode`)",
+				Optional:            true,
+			},
+			"server1": schema.StringAttribute{
+				Description:         "Primary server address",
+				MarkdownDescription: "Primary server address",
+				Required:            true,
+			},
+			"server2": schema.StringAttribute{
+				Description:         "Fallback server address",
+				MarkdownDescription: "Fallback server address",
+				Optional:            true,
+			},
+			"sync_defaults": schema.StringAttribute{
+				Description:         "Default sync options (eg: scope=users,enable-new=1)",
+				MarkdownDescription: "Default sync options (eg: `scope=users,enable-new=1`)",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				Description:         "Realm type, either 'ldap' or 'ad'; changing it forces a new realm",
+				MarkdownDescription: "Realm type, either `ldap` or `ad`; changing it forces a new realm",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_attr": schema.StringAttribute{
+				Description:         "LDAP user attribute name (required for LDAP realms)",
+				MarkdownDescription: "LDAP user attribute name (required for `ldap` realms)",
+				Optional:            true,
+			},
+			"verify": schema.BoolAttribute{
+				Description:         "Verify the server's SSL certificate",
+				MarkdownDescription: "Verify the server's SSL certificate",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *realmResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config realmResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	switch config.Type.ValueString() {
+	case "ldap":
+		if config.BaseDN.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_dn"),
+				"Missing LDAP Base DN", "The base_dn attribute is required for LDAP realms.",
+			)
+		}
+		if config.UserAttr.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("user_attr"),
+				"Missing LDAP User Attribute", "The user_attr attribute is required for LDAP realms.",
+			)
+		}
+	case "ad":
+		if config.Domain.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("domain"),
+				"Missing AD Domain", "The domain attribute is required for Active Directory realms.",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Unsupported Realm Type",
+			fmt.Sprintf("The realm type '%s' is not supported; it must be either 'ldap' or 'ad'.",
+				config.Type.ValueString()),
+		)
+	}
+}
+
+func (r *realmResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan realmResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// create the realm
+	params := r.params(plan, nil)
+	params["realm"] = plan.Realm.ValueString()
+	params["type"] = plan.Type.ValueString()
+	if err := r.providerData.client.Post(ctx, "/access/domains", params, nil); err != nil {
+		tflog.Error(ctx, "failed to create realm", map[string]any{
+			"realm": plan.Realm.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Create Realm",
+			fmt.Sprintf("Failed to create the realm '%s':\n\t%s", plan.Realm.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *realmResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state realmResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the realm configuration
+	realm := state.Realm.ValueString()
+	var config map[string]any
+	err := r.providerData.client.Get(ctx, fmt.Sprintf("/access/domains/%s", url.PathEscape(realm)), &config)
+	if isNotFoundError(err) {
+		tflog.Warn(ctx, "realm no longer exists", map[string]any{"realm": realm})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Realm",
+			fmt.Sprintf("Failed to retrieve the realm '%s':\n\t%s", realm, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model, keeping the password which is never returned
+	state.BaseDN = configString(config, "base_dn")
+	state.BindDN = configString(config, "bind_dn")
+	state.Comment = configString(config, "comment")
+	state.Default = configBool(config, "default", state.Default)
+	state.Domain = configString(config, "domain")
+	state.Mode = configString(config, "mode")
+	state.Port = configInt64(config, "port")
+	state.Secure = configBool(config, "secure", state.Secure)
+	state.Server1 = configString(config, "server1")
+	state.Server2 = configString(config, "server2")
+	state.SyncDefaults = configString(config, "sync-defaults")
+	state.Type = configString(config, "type")
+	state.UserAttr = configString(config, "user_attr")
+	state.Verify = configBool(config, "verify", state.Verify)
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *realmResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan and state
+	var plan, state realmResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update the realm
+	realm := plan.Realm.ValueString()
+	params := r.params(plan, &state)
+	err := r.providerData.client.Put(ctx, fmt.Sprintf("/access/domains/%s", url.PathEscape(realm)), params, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update Realm",
+			fmt.Sprintf("Failed to update the realm '%s':\n\t%s", realm, err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *realmResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state realmResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// delete the realm
+	realm := state.Realm.ValueString()
+	err := r.providerData.client.Delete(ctx, fmt.Sprintf("/access/domains/%s", url.PathEscape(realm)), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Delete Realm",
+			fmt.Sprintf("Failed to delete the realm '%s':\n\t%s", realm, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *realmResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughID(ctx, path.Root("realm"), req, resp)
+}
+
+// params converts the model into API parameters. When the prior state is given, any option that was previously
+// set but has been removed from the plan is added to the list of options to delete.
+func (r *realmResource) params(plan realmResourceModel, state *realmResourceModel) map[string]any {
+	params := map[string]any{}
+	deletes := []string{}
+	setString := func(key string, planned types.String, prior types.String) {
+		if !planned.IsNull() {
+			params[key] = planned.ValueString()
+		} else if !prior.IsNull() {
+			deletes = append(deletes, key)
+		}
+	}
+	setBool := func(key string, planned types.Bool, prior types.Bool) {
+		if !planned.IsNull() {
+			params[key] = boolToInt(planned.ValueBool())
+		} else if !prior.IsNull() {
+			deletes = append(deletes, key)
+		}
+	}
+
+	if state == nil {
+		state = &realmResourceModel{}
+	}
+	setString("base_dn", plan.BaseDN, state.BaseDN)
+	setString("bind_dn", plan.BindDN, state.BindDN)
+	setString("comment", plan.Comment, state.Comment)
+	setBool("default", plan.Default, state.Default)
+	setString("domain", plan.Domain, state.Domain)
+	setString("mode", plan.Mode, state.Mode)
+	setString("password", plan.Password, state.Password)
+	if !plan.Port.IsNull() {
+		params["port"] = plan.Port.ValueInt64()
+	} else if !state.Port.IsNull() {
+		deletes = append(deletes, "port")
+	}
+	setBool("secure", plan.Secure, state.Secure)
+	setString("server1", plan.Server1, state.Server1)
+	setString("server2", plan.Server2, state.Server2)
+	setString("sync-defaults", plan.SyncDefaults, state.SyncDefaults)
+	setString("user_attr", plan.UserAttr, state.UserAttr)
+	setBool("verify", plan.Verify, state.Verify)
+	if len(deletes) > 0 {
+		params["delete"] = strings.Join(deletes, ",")
+	}
+	return params
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &realmsDataSource{}
+	_ datasource.DataSourceWithConfigure = &realmsDataSource{}
+)
+
+func NewRealmsDataSource() datasource.DataSource {
+	return &realmsDataSource{}
+}
+
+type realmsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type realmsDataSourceModel struct {
+	Data []realmsDataSourceRealmModel `tfsdk:"data"`
+}
+
+type realmsDataSourceRealmModel struct {
+	Comment types.String `tfsdk:"comment"`
+	Default types.Bool   `tfsdk:"default"`
+	Realm   types.String `tfsdk:"realm"`
+	Type    types.String `tfsdk:"type"`
+}
+
+func (d *realmsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *realmsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_realms"
+}
+
+func (d *realmsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Computed: true,
+						},
+						"default": schema.BoolAttribute{
+							Description:         "Whether or not this is the default realm for logins",
+							MarkdownDescription: "Whether or not this is the default realm for logins",
+							Computed:            true,
+						},
+						"realm": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Realm type (pam, pve, ldap, ad or openid)",
+							MarkdownDescription: "Realm type (`pam`, `pve`, `ldap`, `ad` or `openid`)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *realmsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// query for the realms
+	domains, err := d.providerData.client.Domains(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve realms", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Realms",
+			fmt.Sprintf("Failed to retrieve the authentication realms:\n\t%s", err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := realmsDataSourceModel{
+		Data: []realmsDataSourceRealmModel{},
+	}
+	for _, domain := range domains {
+		state.Data = append(state.Data, realmsDataSourceRealmModel{
+			Comment: types.StringValue(domain.Comment),
+			Default: types.BoolValue(bool(domain.Default)),
+			Realm:   types.StringValue(domain.Realm),
+			Type:    types.StringValue(domain.Type),
+		})
+	}
+	sort.Slice(state.Data, func(i, j int) bool {
+		return state.Data[i].Realm.ValueString() < state.Data[j].Realm.ValueString()
+	})
+
+	// set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxHostnameLength is the maximum length of a single DNS label.
+const maxHostnameLength = 63
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &sanitizeHostnameFunction{}
+)
+
+func NewSanitizeHostnameFunction() function.Function {
+	return &sanitizeHostnameFunction{}
+}
+
+type sanitizeHostnameFunction struct{}
+
+func (f *sanitizeHostnameFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "sanitize_hostname"
+}
+
+func (f *sanitizeHostnameFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Converts an arbitrary string into a valid VM hostname",
+		Description: "Converts an arbitrary string into a valid Proxmox VE VM name / DNS hostname label. Accents " +
+			"are removed from letters, the result is lower-cased, every run of characters other than a-z and 0-9 " +
+			"is replaced with a single hyphen, leading and trailing hyphens are removed and the result is " +
+			"truncated to 63 characters. An error is returned if nothing remains.",
+		MarkdownDescription: "Converts an arbitrary string into a valid Proxmox VE VM name / DNS hostname label. " +
+			"Accents are removed from letters, the result is lower-cased, every run of characters other than " +
+			"`a-z` and `0-9` is replaced with a single hyphen, leading and trailing hyphens are removed and the " +
+			"result is truncated to 63 characters. An error is returned if nothing remains.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "String to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *sanitizeHostnameFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	hostname := sanitizeHostname(input)
+	if hostname == "" {
+		resp.Error = function.NewArgumentFuncError(0,
+			"The input does not contain any characters that can be used in a hostname.")
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, hostname))
+}
+
+// sanitizeHostname converts the given string into a valid hostname label, returning an empty string if no
+// usable characters remain.
+func sanitizeHostname(input string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range norm.NFKD.String(input) {
+		if unicode.Is(unicode.Mn, r) {
+			// drop combining marks left over from decomposing accented letters
+			continue
+		}
+		r = unicode.ToLower(r)
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			hyphen = false
+		} else if !hyphen && b.Len() > 0 {
+			b.WriteRune('-')
+			hyphen = true
+		}
+	}
+	hostname := b.String()
+	if len(hostname) > maxHostnameLength {
+		hostname = hostname[:maxHostnameLength]
+	}
+	return strings.Trim(hostname, "-")
+}
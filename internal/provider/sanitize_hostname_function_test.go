@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeHostname(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "valid", input: "web-01", want: "web-01"},
+		{name: "uppercase", input: "Web-01", want: "web-01"},
+		{name: "spaces", input: "  my  web server ", want: "my-web-server"},
+		{name: "accents", input: "Café Müller", want: "cafe-muller"},
+		{name: "compatibility characters", input: "ｗｅｂ①", want: "web1"},
+		{name: "symbols", input: "app_01.prod@example", want: "app-01-prod-example"},
+		{name: "hyphen runs", input: "a---b__c", want: "a-b-c"},
+		{name: "leading and trailing", input: "--web--", want: "web"},
+		{name: "nothing usable", input: "日本語", want: ""},
+		{name: "empty", input: "", want: ""},
+		{name: "too long", input: strings.Repeat("a", 70), want: strings.Repeat("a", 63)},
+		{name: "too long ending in a hyphen", input: strings.Repeat("a", 62) + " b", want: strings.Repeat("a", 62)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := sanitizeHostname(test.input)
+			if got != test.want {
+				t.Errorf("sanitizeHostname(%q) = %q, want %q", test.input, got, test.want)
+			}
+			if len(got) > maxHostnameLength {
+				t.Errorf("sanitizeHostname(%q) is %d characters long", test.input, len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// sdnPendingPaths are the SDN object collections which stage their changes until they are applied.
+var sdnPendingPaths = []string{
+	"/cluster/sdn/controllers",
+	"/cluster/sdn/zones",
+	"/cluster/sdn/vnets",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &sdnApplyResource{}
+	_ resource.ResourceWithConfigure = &sdnApplyResource{}
+)
+
+func NewSDNApplyResource() resource.Resource {
+	return &sdnApplyResource{}
+}
+
+type sdnApplyResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type sdnApplyResourceModel struct {
+	Applied  types.Bool `tfsdk:"applied"`
+	Triggers types.Map  `tfsdk:"triggers"`
+}
+
+func (r *sdnApplyResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *sdnApplyResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_sdn_apply"
+}
+
+func (r *sdnApplyResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Applies pending SDN changes to the cluster. If pending changes are found when the resource " +
+			"is refreshed, they are applied again on the next apply. Destroying the resource does not revert " +
+			"anything.",
+		MarkdownDescription: "Applies pending SDN changes to the cluster. If pending changes are found when the " +
+			"resource is refreshed, they are applied again on the next apply. Destroying the resource does not " +
+			"revert anything.",
+		Attributes: map[string]schema.Attribute{
+			"applied": schema.BoolAttribute{
+				Description:         "Whether or not there were pending changes which needed to be applied",
+				MarkdownDescription: "Whether or not there were pending changes which needed to be applied",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which cause the SDN configuration to be applied again when " +
+					"changed",
+				MarkdownDescription: "Arbitrary values which cause the SDN configuration to be applied again when " +
+					"changed",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sdnApplyResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan sdnApplyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// apply the changes only if there are any
+	pending := r.providerData.sdnPendingChanges(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if pending {
+		var upid proxmox.UPID
+		err := r.providerData.client.Put(ctx, "/cluster/sdn", nil, &upid)
+		if err == nil && upid != "" {
+			err = r.providerData.waitForTask(ctx, proxmox.NewTask(upid, r.providerData.client))
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Apply SDN Configuration",
+				fmt.Sprintf("Failed to apply the pending SDN configuration:\n\t%s", err.Error()),
+			)
+			return
+		}
+	} else {
+		tflog.Info(ctx, "no pending SDN changes to apply")
+	}
+	plan.Applied = types.BoolValue(pending)
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *sdnApplyResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state sdnApplyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// removing the resource when there are pending changes causes them to be applied on the next apply
+	pending := r.providerData.sdnPendingChanges(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if pending {
+		tflog.Warn(ctx, "SDN configuration has pending changes")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *sdnApplyResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan; all configurable attributes require replacement so there is nothing to update
+	var plan sdnApplyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *sdnApplyResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	// applied changes cannot be reverted
+}
+
+// sdnPendingChanges returns whether or not any SDN object has changes which have not been applied yet.
+func (p *proxmoxveProviderData) sdnPendingChanges(ctx context.Context, diags *diag.Diagnostics) bool {
+	var vnets []string
+	for _, path := range sdnPendingPaths {
+		var objects []map[string]any
+		if err := p.client.Get(ctx, path+"?pending=1", &objects); err != nil {
+			diags.AddError(
+				"Proxmox VE API: Failed to Retrieve SDN Configuration",
+				fmt.Sprintf("Failed to retrieve the SDN configuration from '%s':\n\t%s", path, err.Error()),
+			)
+			return false
+		}
+		for _, object := range objects {
+			if configString(object, "state").ValueString() != "" {
+				return true
+			}
+			if vnet := configString(object, "vnet").ValueString(); vnet != "" {
+				vnets = append(vnets, vnet)
+			}
+		}
+	}
+
+	// subnets are staged separately for each VNet
+	for _, vnet := range vnets {
+		var subnets []map[string]any
+		err := p.client.Get(ctx, fmt.Sprintf("/cluster/sdn/vnets/%s/subnets?pending=1", url.PathEscape(vnet)),
+			&subnets)
+		if err != nil {
+			diags.AddError(
+				"Proxmox VE API: Failed to Retrieve SDN Configuration",
+				fmt.Sprintf("Failed to retrieve the subnets of the VNet '%s':\n\t%s", vnet, err.Error()),
+			)
+			return false
+		}
+		for _, subnet := range subnets {
+			if configString(subnet, "state").ValueString() != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// startupModel is the parsed startup option of a VM or container (eg: 'order=1,up=30,down=60').
+type startupModel struct {
+	Down  types.Int64 `tfsdk:"down"`
+	Order types.Int64 `tfsdk:"order"`
+	Up    types.Int64 `tfsdk:"up"`
+}
+
+// parseStartup parses the startup option of a VM or container, returning nil if it is not set. A leading value
+// without a key is treated as the order.
+func parseStartup(value string) *startupModel {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	properties := parsePropertyString(value, "order")
+	return &startupModel{
+		Down:  propertyInt64(properties, "down"),
+		Order: propertyInt64(properties, "order"),
+		Up:    propertyInt64(properties, "up"),
+	}
+}
+
+// formatStartup returns the startup option for the given order and delays, omitting null values. An empty
+// string is returned when all of them are null.
+func formatStartup(order, up, down types.Int64) string {
+	properties := []string{}
+	for _, property := range []struct {
+		key   string
+		value types.Int64
+	}{{"order", order}, {"up", up}, {"down", down}} {
+		if !property.value.IsNull() && !property.value.IsUnknown() {
+			properties = append(properties, property.key+"="+strconv.FormatInt(property.value.ValueInt64(), 10))
+		}
+	}
+	return strings.Join(properties, ",")
+}
+
+// startupDataSourceSchemaAttribute returns the data source schema attribute for a parsed startup option.
+func startupDataSourceSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         "Startup and shutdown behavior when the node boots; null when unset",
+		MarkdownDescription: "Startup and shutdown behavior when the node boots; null when unset",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"down": schema.Int64Attribute{
+				Description:         "Timeout in seconds to wait for the guest to shut down; null when unset",
+				MarkdownDescription: "Timeout in seconds to wait for the guest to shut down; null when unset",
+				Computed:            true,
+			},
+			"order": schema.Int64Attribute{
+				Description: "Position in the startup order; guests are started in ascending and shut down " +
+					"in descending order; null when unset",
+				MarkdownDescription: "Position in the startup order; guests are started in ascending and shut " +
+					"down in descending order; null when unset",
+				Computed: true,
+			},
+			"up": schema.Int64Attribute{
+				Description:         "Delay in seconds before the next guest is started; null when unset",
+				MarkdownDescription: "Delay in seconds before the next guest is started; null when unset",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseStartup(t *testing.T) {
+	tests := []struct {
+		value string
+		want  *startupModel
+	}{
+		{
+			value: "order=1,up=30,down=60",
+			want:  &startupModel{Down: types.Int64Value(60), Order: types.Int64Value(1), Up: types.Int64Value(30)},
+		},
+		{
+			value: "2,up=10",
+			want:  &startupModel{Down: types.Int64Null(), Order: types.Int64Value(2), Up: types.Int64Value(10)},
+		},
+		{value: " ", want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if got := parseStartup(test.value); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseStartup(%q) = %+v, want %+v", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatStartup(t *testing.T) {
+	tests := []struct {
+		name  string
+		order types.Int64
+		up    types.Int64
+		down  types.Int64
+		want  string
+	}{
+		{
+			name:  "order with delays",
+			order: types.Int64Value(3),
+			up:    types.Int64Value(30),
+			down:  types.Int64Value(60),
+			want:  "order=3,up=30,down=60",
+		},
+		{
+			name:  "delays cleared",
+			order: types.Int64Value(3),
+			up:    types.Int64Null(),
+			down:  types.Int64Unknown(),
+			want:  "order=3",
+		},
+		{name: "all cleared", order: types.Int64Null(), up: types.Int64Null(), down: types.Int64Null(), want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := formatStartup(test.order, test.up, test.down); got != test.want {
+				t.Errorf("formatStartup() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
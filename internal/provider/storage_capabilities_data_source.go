@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &storageCapabilitiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &storageCapabilitiesDataSource{}
+)
+
+func NewStorageCapabilitiesDataSource() datasource.DataSource {
+	return &storageCapabilitiesDataSource{}
+}
+
+type storageCapabilitiesDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type storageCapabilitiesDataSourceModel struct {
+	Data   *storageCapabilitiesDataSourceDataModel   `tfsdk:"data"`
+	Filter *storageCapabilitiesDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type storageCapabilitiesDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	Storage  types.String `tfsdk:"storage"`
+}
+
+type storageCapabilitiesDataSourceDataModel struct {
+	Content          []types.String `tfsdk:"content"`
+	SupportsBackup   types.Bool     `tfsdk:"supports_backup"`
+	SupportsImages   types.Bool     `tfsdk:"supports_images"`
+	SupportsISO      types.Bool     `tfsdk:"supports_iso"`
+	SupportsRootDir  types.Bool     `tfsdk:"supports_rootdir"`
+	SupportsSnippets types.Bool     `tfsdk:"supports_snippets"`
+	SupportsVZTmpl   types.Bool     `tfsdk:"supports_vztmpl"`
+	Type             types.String   `tfsdk:"type"`
+}
+
+func (d *storageCapabilitiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *storageCapabilitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_storage_capabilities"
+}
+
+func (d *storageCapabilitiesDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves which kinds of content a storage on a node can hold, derived from the content " +
+			"types enabled on the storage.",
+		MarkdownDescription: "Retrieves which kinds of content a storage on a node can hold, derived from the " +
+			"content types enabled on the storage.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"content": schema.ListAttribute{
+						Description:         "Sorted list of content types enabled on the storage",
+						MarkdownDescription: "Sorted list of content types enabled on the storage",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"supports_backup": schema.BoolAttribute{
+						Description:         "Whether or not the storage can hold backups (backup)",
+						MarkdownDescription: "Whether or not the storage can hold backups (`backup`)",
+						Computed:            true,
+					},
+					"supports_images": schema.BoolAttribute{
+						Description:         "Whether or not the storage can hold VM disk images (images)",
+						MarkdownDescription: "Whether or not the storage can hold VM disk images (`images`)",
+						Computed:            true,
+					},
+					"supports_iso": schema.BoolAttribute{
+						Description:         "Whether or not the storage can hold ISO images (iso)",
+						MarkdownDescription: "Whether or not the storage can hold ISO images (`iso`)",
+						Computed:            true,
+					},
+					"supports_rootdir": schema.BoolAttribute{
+						Description: "Whether or not the storage can hold container root file systems (rootdir)",
+						MarkdownDescription: "Whether or not the storage can hold container root file systems " +
+							"(`rootdir`)",
+						Computed: true,
+					},
+					"supports_snippets": schema.BoolAttribute{
+						Description: "Whether or not the storage can hold snippets such as cloud-init files " +
+							"(snippets)",
+						MarkdownDescription: "Whether or not the storage can hold snippets such as cloud-init " +
+							"files (`snippets`)",
+						Computed: true,
+					},
+					"supports_vztmpl": schema.BoolAttribute{
+						Description:         "Whether or not the storage can hold container templates (vztmpl)",
+						MarkdownDescription: "Whether or not the storage can hold container templates (`vztmpl`)",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						Description:         "Storage type (eg: dir, lvmthin, nfs)",
+						MarkdownDescription: "Storage type (eg: `dir`, `lvmthin`, `nfs`)",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node the storage is available on; defaults to the provider's " +
+							"default_node when omitted",
+						MarkdownDescription: "Name of the node the storage is available on; defaults to the " +
+							"provider's `default_node` when omitted",
+						Optional: true,
+					},
+					"storage": schema.StringAttribute{
+						Description:         "ID of the storage (eg: local)",
+						MarkdownDescription: "ID of the storage (eg: `local`)",
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *storageCapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config storageCapabilitiesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node and storage are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the storage capabilities.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the storage "+
+				"capabilities or configure a default node for the provider.",
+		)
+		return
+	}
+	storageID := strings.TrimSpace(config.Filter.Storage.ValueString())
+	if storageID == "" {
+		resp.Diagnostics.AddError(
+			"Filter Storage Is Required", "You must specify the ID of the storage to retrieve the capabilities for.",
+		)
+		return
+	}
+
+	// query for the storage status which includes its type and enabled content types
+	var storage proxmox.Storage
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/storage/%s/status", url.PathEscape(nodeName),
+		url.PathEscape(storageID)), &storage)
+	if isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Storage Not Found",
+			fmt.Sprintf("No storage with the ID '%s' is available on the cluster node '%s'.", storageID, nodeName),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve storage status", map[string]any{
+			"node_name": nodeName,
+			"storage":   storageID,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Storage",
+			fmt.Sprintf("Failed to retrieve the storage '%s' on the cluster node '%s':\n\t%s", storageID, nodeName,
+				err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	contentTypes := parseStorageContent(storage.Content)
+	state := storageCapabilitiesDataSourceModel{
+		Data: &storageCapabilitiesDataSourceDataModel{
+			Content:          []types.String{},
+			SupportsBackup:   types.BoolValue(slices.Contains(contentTypes, "backup")),
+			SupportsImages:   types.BoolValue(slices.Contains(contentTypes, "images")),
+			SupportsISO:      types.BoolValue(slices.Contains(contentTypes, "iso")),
+			SupportsRootDir:  types.BoolValue(slices.Contains(contentTypes, "rootdir")),
+			SupportsSnippets: types.BoolValue(slices.Contains(contentTypes, "snippets")),
+			SupportsVZTmpl:   types.BoolValue(slices.Contains(contentTypes, "vztmpl")),
+			Type:             types.StringValue(strings.ToLower(storage.Type)),
+		},
+		Filter: config.Filter,
+	}
+	for _, contentType := range contentTypes {
+		state.Data.Content = append(state.Data.Content, types.StringValue(contentType))
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
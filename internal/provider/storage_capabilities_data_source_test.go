@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStorageCapabilitiesRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage map[string]any
+		want    storageCapabilitiesDataSourceDataModel
+	}{
+		{
+			name:    "multiple content types",
+			storage: map[string]any{"type": "dir", "content": "vztmpl,iso,rootdir,images,backup"},
+			want: storageCapabilitiesDataSourceDataModel{
+				Content: []types.String{
+					types.StringValue("backup"),
+					types.StringValue("images"),
+					types.StringValue("iso"),
+					types.StringValue("rootdir"),
+					types.StringValue("vztmpl"),
+				},
+				SupportsBackup:   types.BoolValue(true),
+				SupportsImages:   types.BoolValue(true),
+				SupportsISO:      types.BoolValue(true),
+				SupportsRootDir:  types.BoolValue(true),
+				SupportsSnippets: types.BoolValue(false),
+				SupportsVZTmpl:   types.BoolValue(true),
+				Type:             types.StringValue("dir"),
+			},
+		},
+		{
+			name:    "single content type",
+			storage: map[string]any{"type": "LVMThin", "content": "images"},
+			want: storageCapabilitiesDataSourceDataModel{
+				Content:          []types.String{types.StringValue("images")},
+				SupportsBackup:   types.BoolValue(false),
+				SupportsImages:   types.BoolValue(true),
+				SupportsISO:      types.BoolValue(false),
+				SupportsRootDir:  types.BoolValue(false),
+				SupportsSnippets: types.BoolValue(false),
+				SupportsVZTmpl:   types.BoolValue(false),
+				Type:             types.StringValue("lvmthin"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api2/json/nodes/pve1/storage/local/status" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+					return
+				}
+				writeTestData(t, w, test.storage)
+			})
+			config := storageCapabilitiesDataSourceModel{
+				Filter: &storageCapabilitiesDataSourceFilterModel{
+					NodeName: types.StringValue("pve1"),
+					Storage:  types.StringValue("local"),
+				},
+			}
+			var state storageCapabilitiesDataSourceModel
+			diags := readTestDataSource(t, &storageCapabilitiesDataSource{providerData: data}, config, &state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(*state.Data, test.want) {
+				t.Errorf("data = %+v, want %+v", *state.Data, test.want)
+			}
+		})
+	}
+}
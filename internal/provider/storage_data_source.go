@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &storageDataSource{}
+	_ datasource.DataSourceWithConfigure = &storageDataSource{}
+)
+
+func NewStorageDataSource() datasource.DataSource {
+	return &storageDataSource{}
+}
+
+type storageDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type storageDataSourceModel struct {
+	Data   []storageDataSourceStorageModel `tfsdk:"data"`
+	Filter *storageDataSourceFilterModel   `tfsdk:"filter"`
+}
+
+type storageDataSourceFilterModel struct {
+	Content  types.String `tfsdk:"content"`
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+type storageDataSourceStorageModel struct {
+	Active         types.Bool     `tfsdk:"active"`
+	AvailableBytes types.Int64    `tfsdk:"avail"`
+	Content        []types.String `tfsdk:"content"`
+	Enabled        types.Bool     `tfsdk:"enabled"`
+	Name           types.String   `tfsdk:"name"`
+	Shared         types.Bool     `tfsdk:"shared"`
+	TotalBytes     types.Int64    `tfsdk:"total"`
+	Type           types.String   `tfsdk:"type"`
+	UsedBytes      types.Int64    `tfsdk:"used"`
+}
+
+func (d *storageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *storageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_storage"
+}
+
+func (d *storageDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"active": schema.BoolAttribute{
+							Computed: true,
+						},
+						"avail": schema.Int64Attribute{
+							Computed: true,
+						},
+						"content": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"enabled": schema.BoolAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"shared": schema.BoolAttribute{
+							Computed: true,
+						},
+						"total": schema.Int64Attribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"used": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"content": schema.StringAttribute{
+						Description:         "Only return storages supporting this content type (eg: images, iso)",
+						MarkdownDescription: "Only return storages supporting this content type (eg: `images`, `iso`)",
+						Optional:            true,
+					},
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node to list storages for; defaults to the provider's " +
+							"default_node when omitted",
+						MarkdownDescription: "Name of the node to list storages for; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *storageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config storageDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a node is specified
+	filter := config.Filter
+	if filter == nil {
+		filter = &storageDataSourceFilterModel{
+			Content:  types.StringNull(),
+			NodeName: types.StringNull(),
+		}
+	}
+	nodeName := d.providerData.NodeName(filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the storages "+
+				"or configure a default node for the provider.",
+		)
+		return
+	}
+	content := strings.ToLower(strings.TrimSpace(filter.Content.ValueString()))
+
+	// query for the storages, letting the API filter by content type when requested
+	query := url.Values{}
+	if content != "" {
+		query.Set("content", content)
+	}
+	apiPath := fmt.Sprintf("/nodes/%s/storage", url.PathEscape(nodeName))
+	if len(query) > 0 {
+		apiPath += "?" + query.Encode()
+	}
+	var storages proxmox.Storages
+	if err := d.providerData.client.Get(ctx, apiPath, &storages); err != nil {
+		tflog.Error(ctx, "failed to retrieve storages", map[string]any{
+			"node_name": nodeName,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Storages",
+			fmt.Sprintf("Failed to retrieve the storages for the cluster node '%s':\n\t%s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := storageDataSourceModel{
+		Data:   []storageDataSourceStorageModel{},
+		Filter: config.Filter,
+	}
+	for _, storage := range storages {
+		contentTypes := parseStorageContent(storage.Content)
+		if content != "" && !slices.Contains(contentTypes, content) {
+			// older API versions may ignore the content parameter so filter client-side as well
+			continue
+		}
+		model := storageDataSourceStorageModel{
+			Active:         types.BoolValue(storage.Active == 1),
+			AvailableBytes: uint64Value(storage.Avail),
+			Content:        []types.String{},
+			Enabled:        types.BoolValue(storage.Enabled == 1),
+			Name:           types.StringValue(storage.Name),
+			Shared:         types.BoolValue(storage.Shared == 1),
+			TotalBytes:     uint64Value(storage.Total),
+			Type:           types.StringValue(strings.ToLower(storage.Type)),
+			UsedBytes:      uint64Value(storage.Used),
+		}
+		for _, contentType := range contentTypes {
+			model.Content = append(model.Content, types.StringValue(contentType))
+		}
+		state.Data = append(state.Data, model)
+	}
+	sort.Slice(state.Data, func(i, j int) bool {
+		return state.Data[i].Name.ValueString() < state.Data[j].Name.ValueString()
+	})
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// parseStorageContent splits the comma-separated content string returned by the API into a sorted,
+// lower-cased and de-duplicated list of content types.
+func parseStorageContent(content string) []string {
+	contentTypes := []string{}
+	for _, value := range strings.Split(content, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || slices.Contains(contentTypes, value) {
+			continue
+		}
+		contentTypes = append(contentTypes, value)
+	}
+	sort.Strings(contentTypes)
+	return contentTypes
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStorageContent(t *testing.T) {
+	tests := []struct {
+		content string
+		want    []string
+	}{
+		{content: "images,rootdir", want: []string{"images", "rootdir"}},
+		{content: "rootdir,images", want: []string{"images", "rootdir"}},
+		{content: " ISO , vztmpl,iso,, backup ", want: []string{"backup", "iso", "vztmpl"}},
+		{content: "", want: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.content, func(t *testing.T) {
+			if got := parseStorageContent(test.content); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseStorageContent(%q) = %v, want %v", test.content, got, test.want)
+			}
+		})
+	}
+}
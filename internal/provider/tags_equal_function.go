@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &tagsEqualFunction{}
+)
+
+func NewTagsEqualFunction() function.Function {
+	return &tagsEqualFunction{}
+}
+
+type tagsEqualFunction struct{}
+
+func (f *tagsEqualFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "tags_equal"
+}
+
+func (f *tagsEqualFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Compares two Proxmox VE tag strings",
+		Description: "Returns whether or not two raw tag strings contain the same tags, ignoring order, case, " +
+			"duplicates and which delimiters are used (eg: 'web;db' and 'DB, web' are equal).",
+		MarkdownDescription: "Returns whether or not two raw tag strings contain the same tags, ignoring order, " +
+			"case, duplicates and which delimiters are used (eg: `web;db` and `DB, web` are equal).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "First raw tag string",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "Second raw tag string",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *tagsEqualFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var a, b string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, slices.Equal(normalizeTags(a), normalizeTags(b))))
+}
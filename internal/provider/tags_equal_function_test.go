@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTagsEqualFunctionRun(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "web;prod", b: "prod,web", want: true},
+		{a: "Web;PROD", b: "prod web", want: true},
+		{a: "web;web;prod", b: "prod;web", want: true},
+		{a: "", b: " ; ", want: true},
+		{a: "web;prod", b: "web", want: false},
+		{a: "web;prod", b: "web;dev", want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.a+" = "+test.b, func(t *testing.T) {
+			args := []attr.Value{types.StringValue(test.a), types.StringValue(test.b)}
+			req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+			resp := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+			(&tagsEqualFunction{}).Run(context.Background(), req, &resp)
+			if resp.Error != nil {
+				t.Fatalf("Run() unexpected error: %v", resp.Error)
+			}
+			if want := types.BoolValue(test.want); !resp.Result.Value().Equal(want) {
+				t.Errorf("Run(%q, %q) = %v, want %v", test.a, test.b, resp.Result.Value(), want)
+			}
+		})
+	}
+}
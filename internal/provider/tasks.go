@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+const (
+	defaultTaskPollMinInterval = 500 * time.Millisecond
+	defaultTaskPollMaxInterval = 10 * time.Second
+	defaultTaskPollMultiplier  = 1.5
+
+	// taskLogPageSize is the maximum number of task log lines read each time a task is polled.
+	taskLogPageSize = 500
+)
+
+// taskPollSettings controls how often the status of a long-running task is polled. Polling starts at the
+// minimum interval and backs off exponentially by the multiplier until the maximum interval is reached.
+type taskPollSettings struct {
+	minInterval time.Duration
+	maxInterval time.Duration
+	multiplier  float64
+}
+
+// nextInterval returns the polling interval to use after the given interval has elapsed.
+func (s taskPollSettings) nextInterval(interval time.Duration) time.Duration {
+	next := time.Duration(float64(interval) * s.multiplier)
+	if next > s.maxInterval {
+		return s.maxInterval
+	}
+	return next
+}
+
+// taskLogFunc is called for each new line of a task's log while waiting for the task to complete.
+type taskLogFunc func(line string)
+
+// waitForTask polls the given task until it completes, returning an error if the task failed, the provider's
+// operation timeout passed or the context was cancelled before the task finished.
+func (p *proxmoxveProviderData) waitForTask(ctx context.Context, task *proxmox.Task) error {
+	return p.waitForTaskWithLog(ctx, task, nil)
+}
+
+// waitForTaskWithLog behaves like waitForTask but also passes each new line of the task's log to the given
+// function every time the task is polled.
+func (p *proxmoxveProviderData) waitForTaskWithLog(ctx context.Context, task *proxmox.Task,
+	onLog taskLogFunc) error {
+
+	if p.operationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.operationTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	interval := p.taskPoll.minInterval
+	nextLine := 0
+	for {
+		if err := task.Ping(ctx); err != nil {
+			return taskWaitError(ctx, task, start, err)
+		}
+		if onLog != nil {
+			nextLine = p.followTaskLog(ctx, task, nextLine, onLog)
+		}
+		if task.IsCompleted {
+			if task.IsFailed {
+				return fmt.Errorf("task '%s' failed: %s", task.UPID, task.ExitStatus)
+			}
+			return nil
+		}
+		tflog.Debug(ctx, "waiting for task to complete", map[string]any{
+			"upid":     task.UPID,
+			"interval": interval.String(),
+		})
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return taskWaitError(ctx, task, start, ctx.Err())
+		case <-timer.C:
+		}
+		interval = p.taskPoll.nextInterval(interval)
+	}
+}
+
+// taskWaitError returns the error to report when waiting for the given task stopped with the given error. When
+// the deadline of the context passed, the error names the task and how long it was waited for so that it can be
+// followed up on the server.
+func taskWaitError(ctx context.Context, task *proxmox.Task, start time.Time, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out after %s waiting for task '%s' to complete; the task may still be running",
+			time.Since(start).Round(time.Second), task.UPID)
+	}
+	return err
+}
+
+// followTaskLog passes the lines of the task's log starting at the given line to the given function and returns
+// the index of the next line to read. Failing to read the log is not fatal since it is only informational.
+func (p *proxmoxveProviderData) followTaskLog(ctx context.Context, task *proxmox.Task, start int,
+	onLog taskLogFunc) int {
+
+	log, err := task.Log(ctx, start, taskLogPageSize)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read task log", map[string]any{
+			"upid":  task.UPID,
+			"error": err.Error(),
+		})
+		return start
+	}
+	lines := slices.Sorted(maps.Keys(log))
+	for _, n := range lines {
+		onLog(log[n])
+	}
+	if len(lines) > 0 {
+		return lines[len(lines)-1] + 1
+	}
+	return start
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+const testTaskUPID = "UPID:pve1:0000A1B2:0001C3D4:6523F0A0:qmstart:100:root@pam:"
+
+func TestTaskPollSettingsNextInterval(t *testing.T) {
+	poll := taskPollSettings{minInterval: 500 * time.Millisecond, maxInterval: 2 * time.Second, multiplier: 1.5}
+	want := []time.Duration{
+		750 * time.Millisecond,
+		1125 * time.Millisecond,
+		1687500 * time.Microsecond,
+		2 * time.Second,
+		2 * time.Second,
+	}
+	interval := poll.minInterval
+	for i, w := range want {
+		interval = poll.nextInterval(interval)
+		if interval != w {
+			t.Fatalf("interval after %d polls = %s, want %s", i+1, interval, w)
+		}
+	}
+}
+
+func TestWaitForTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  map[string]any
+		wantErr string
+	}{
+		{
+			name:   "succeeded",
+			status: map[string]any{"upid": testTaskUPID, "status": "stopped", "exitstatus": "OK"},
+		},
+		{
+			name: "failed",
+			status: map[string]any{
+				"upid":       testTaskUPID,
+				"status":     "stopped",
+				"exitstatus": "start failed: no such disk",
+			},
+			wantErr: "task '" + testTaskUPID + "' failed: start failed: no such disk",
+		},
+		{
+			name:    "timed out",
+			status:  map[string]any{"upid": testTaskUPID, "status": "running"},
+			wantErr: "waiting for task '" + testTaskUPID + "' to complete; the task may still be running",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+				writeTestData(t, w, test.status)
+			})
+			data.operationTimeout = 100 * time.Millisecond
+			data.taskPoll = taskPollSettings{
+				minInterval: 10 * time.Millisecond,
+				maxInterval: 20 * time.Millisecond,
+				multiplier:  2,
+			}
+			err := data.waitForTask(context.Background(), proxmox.NewTask(testTaskUPID, data.client))
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("waitForTask() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("waitForTask() error = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
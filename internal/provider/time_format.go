@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// timeFormatEpoch formats timestamps as the number of seconds since the Unix epoch.
+	timeFormatEpoch = "epoch"
+
+	// timeFormatRFC3339 formats timestamps as RFC 3339 date and time strings in UTC.
+	timeFormatRFC3339 = "rfc3339"
+)
+
+// parseTimeFormat validates the time_format provider attribute, defaulting to epoch when it is empty.
+func parseTimeFormat(value string) (string, error) {
+	switch format := strings.ToLower(strings.TrimSpace(value)); format {
+	case "":
+		return timeFormatEpoch, nil
+	case timeFormatEpoch, timeFormatRFC3339:
+		return format, nil
+	default:
+		return "", fmt.Errorf("'%s' is not a supported time format (%s or %s)", value, timeFormatEpoch,
+			timeFormatRFC3339)
+	}
+}
+
+// formatTimestamp formats a Unix timestamp returned by the API using the provider's time format.
+func (p *proxmoxveProviderData) formatTimestamp(unix int64) types.String {
+	if p.timeFormat == timeFormatRFC3339 {
+		return types.StringValue(time.Unix(unix, 0).UTC().Format(time.RFC3339))
+	}
+	return types.StringValue(strconv.FormatInt(unix, 10))
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// tlsCurves are the elliptic curves which may be used in the tls_curve_preferences provider attribute, keyed by
+// their Go name.
+var tlsCurves = map[string]tls.CurveID{
+	tls.X25519.String():    tls.X25519,
+	tls.CurveP256.String(): tls.CurveP256,
+	tls.CurveP384.String(): tls.CurveP384,
+	tls.CurveP521.String(): tls.CurveP521,
+}
+
+// parseTLSCipherSuites converts the given cipher suite names (eg: TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256) into
+// their IDs, returning an error naming the first unknown suite. An empty list results in Go's defaults. Suites
+// Go considers insecure are accepted since some compliance regimes require them to be listed explicitly.
+func parseTLSCipherSuites(names []string) ([]uint16, error) {
+	known := map[string]uint16{}
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		known[suite.Name] = suite.ID
+	}
+
+	var ids []uint16
+	for _, name := range names {
+		id, ok := known[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS cipher suite '%s'; it must be one of: %s", name,
+				strings.Join(slices.Sorted(maps.Keys(known)), ", "))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseTLSCurvePreferences converts the given curve names (eg: X25519, CurveP256) into their IDs, returning an
+// error naming the first unknown curve. An empty list results in Go's defaults.
+func parseTLSCurvePreferences(names []string) ([]tls.CurveID, error) {
+	var ids []tls.CurveID
+	for _, name := range names {
+		id, ok := tlsCurves[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS curve '%s'; it must be one of: %s", name,
+				strings.Join(slices.Sorted(maps.Keys(tlsCurves)), ", "))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// tlsFingerprintMismatchError is returned when the endpoint presents a certificate whose fingerprint does not
+// match the pinned fingerprint.
+type tlsFingerprintMismatchError struct {
+	actual   string
+	expected string
+}
+
+func (e *tlsFingerprintMismatchError) Error() string {
+	return fmt.Sprintf("the server certificate fingerprint '%s' does not match the expected fingerprint '%s'",
+		formatTLSFingerprint(e.actual), formatTLSFingerprint(e.expected))
+}
+
+// parseTLSFingerprint normalizes a SHA-256 certificate fingerprint given either as plain hex or as colon
+// separated pairs (as displayed by the Proxmox VE UI) into lower-case hex.
+func parseTLSFingerprint(value string) (string, error) {
+	fingerprint := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(value), ":", ""))
+	if decoded, err := hex.DecodeString(fingerprint); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("'%s' is not a SHA-256 fingerprint (eg: AB:CD:...:EF)", value)
+	}
+	return fingerprint, nil
+}
+
+// formatTLSFingerprint formats a lower-case hex fingerprint as upper-case colon separated pairs.
+func formatTLSFingerprint(fingerprint string) string {
+	pairs := []string{}
+	for i := 0; i+1 < len(fingerprint); i += 2 {
+		pairs = append(pairs, strings.ToUpper(fingerprint[i:i+2]))
+	}
+	return strings.Join(pairs, ":")
+}
+
+// verifyTLSFingerprint returns a function for tls.Config.VerifyPeerCertificate which accepts the connection
+// only if the leaf certificate matches the given normalized fingerprint, regardless of whether its chain is
+// trusted.
+func verifyTLSFingerprint(fingerprint string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("the server did not present a certificate")
+		}
+		sum := sha256.Sum256(rawCerts[0])
+		actual := hex.EncodeToString(sum[:])
+		if actual != fingerprint {
+			return &tlsFingerprintMismatchError{actual: actual, expected: fingerprint}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &userGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &userGroupMembershipResource{}
+)
+
+func NewUserGroupMembershipResource() resource.Resource {
+	return &userGroupMembershipResource{}
+}
+
+type userGroupMembershipResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type userGroupMembershipResourceModel struct {
+	Groups []types.String `tfsdk:"groups"`
+	UserID types.String   `tfsdk:"userid"`
+}
+
+func (r *userGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *userGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_user_group_membership"
+}
+
+func (r *userGroupMembershipResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages which groups an existing user belongs to without managing the user itself. Groups " +
+			"the user joins outside of Terraform are removed on the next apply. Destroying the resource removes " +
+			"the user from the managed groups only. Existing memberships are imported using the user ID.",
+		MarkdownDescription: "Manages which groups an existing user belongs to without managing the user itself. " +
+			"Groups the user joins outside of Terraform are removed on the next apply. Destroying the resource " +
+			"removes the user from the managed `groups` only. Existing memberships are imported using the " +
+			"`userid`.",
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.SetAttribute{
+				Description:         "IDs of the groups the user belongs to",
+				MarkdownDescription: "IDs of the groups the user belongs to",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"userid": schema.StringAttribute{
+				Description:         "ID of the user including the realm (eg: jdoe@pve)",
+				MarkdownDescription: "ID of the user including the realm (eg: `jdoe@pve`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan userGroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the groups of the user
+	userID := plan.UserID.ValueString()
+	if err := r.setGroups(ctx, userID, stringValues(plan.Groups)); err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update User Groups",
+			fmt.Sprintf("Failed to set the groups of the user '%s':\n\t%s", userID, err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state userGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the current groups of the user
+	userID := state.UserID.ValueString()
+	groups, err := r.groups(ctx, userID)
+	if isNotFoundError(err) {
+		tflog.Warn(ctx, "user no longer exists", map[string]any{"userid": userID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve User",
+			fmt.Sprintf("Failed to retrieve the user '%s':\n\t%s", userID, err.Error()),
+		)
+		return
+	}
+	state.Groups = []types.String{}
+	for _, group := range groups {
+		state.Groups = append(state.Groups, types.StringValue(group))
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan and state
+	var plan, state userGroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// apply the groups which were added and removed to the current membership
+	userID := plan.UserID.ValueString()
+	planned := stringValues(plan.Groups)
+	previous := stringValues(state.Groups)
+	groups, err := r.groups(ctx, userID)
+	if err == nil {
+		groups = slices.DeleteFunc(groups, func(group string) bool {
+			return slices.Contains(previous, group) && !slices.Contains(planned, group)
+		})
+		for _, group := range planned {
+			if !slices.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+		err = r.setGroups(ctx, userID, groups)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update User Groups",
+			fmt.Sprintf("Failed to update the groups of the user '%s':\n\t%s", userID, err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state userGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remove the user from the managed groups, keeping any it has joined since
+	userID := state.UserID.ValueString()
+	managed := stringValues(state.Groups)
+	groups, err := r.groups(ctx, userID)
+	if isNotFoundError(err) {
+		return
+	}
+	if err == nil {
+		err = r.setGroups(ctx, userID, slices.DeleteFunc(groups, func(group string) bool {
+			return slices.Contains(managed, group)
+		}))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update User Groups",
+			fmt.Sprintf("Failed to remove the user '%s' from its groups:\n\t%s", userID, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *userGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughID(ctx, path.Root("userid"), req, resp)
+}
+
+// groups returns the sorted IDs of the groups the given user belongs to. Depending on the version, the API
+// returns them either as a list or as a comma-separated string.
+func (r *userGroupMembershipResource) groups(ctx context.Context, userID string) ([]string, error) {
+	var user map[string]any
+	if err := r.providerData.client.Get(ctx, fmt.Sprintf("/access/users/%s", url.PathEscape(userID)),
+		&user); err != nil {
+		return nil, err
+	}
+	groups := []string{}
+	switch v := user["groups"].(type) {
+	case []any:
+		for _, group := range v {
+			if group, ok := group.(string); ok && group != "" {
+				groups = append(groups, group)
+			}
+		}
+	case string:
+		for _, group := range strings.Split(v, ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	slices.Sort(groups)
+	return groups, nil
+}
+
+// setGroups replaces the groups the given user belongs to.
+func (r *userGroupMembershipResource) setGroups(ctx context.Context, userID string, groups []string) error {
+	tflog.Info(ctx, "setting user groups", map[string]any{"userid": userID, "groups": groups})
+	return r.providerData.client.Put(ctx, fmt.Sprintf("/access/users/%s", url.PathEscape(userID)),
+		map[string]any{"groups": strings.Join(groups, ",")}, nil)
+}
+
+// stringValues returns the known, non-null values of the given strings.
+func stringValues(values []types.String) []string {
+	result := []string{}
+	for _, value := range values {
+		if !value.IsNull() && !value.IsUnknown() {
+			result = append(result, value.ValueString())
+		}
+	}
+	return result
+}
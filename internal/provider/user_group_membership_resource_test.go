@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserGroupMembershipUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  any
+		previous []string
+		planned  []string
+		want     string
+	}{
+		{
+			name:     "add a group",
+			current:  "admins,external",
+			previous: []string{"admins"},
+			planned:  []string{"admins", "ops"},
+			want:     "admins,external,ops",
+		},
+		{
+			name:     "remove a group",
+			current:  []any{"admins", "external", "ops"},
+			previous: []string{"admins", "ops"},
+			planned:  []string{"admins"},
+			want:     "admins,external",
+		},
+		{
+			name:     "add and remove",
+			current:  "admins,ops",
+			previous: []string{"admins", "ops"},
+			planned:  []string{"dev", "ops"},
+			want:     "ops,dev",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got *string
+			data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api2/json/access/users/jdoe@pve" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+					return
+				}
+				switch r.Method {
+				case http.MethodGet:
+					writeTestData(t, w, map[string]any{"userid": "jdoe@pve", "groups": test.current})
+				case http.MethodPut:
+					var body map[string]string
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("failed to decode the request: %v", err)
+					}
+					groups := body["groups"]
+					got = &groups
+					writeTestData(t, w, nil)
+				}
+			})
+			model := func(groups []string) userGroupMembershipResourceModel {
+				m := userGroupMembershipResourceModel{UserID: types.StringValue("jdoe@pve")}
+				for _, group := range groups {
+					m.Groups = append(m.Groups, types.StringValue(group))
+				}
+				return m
+			}
+			diags := updateTestResource(t, &userGroupMembershipResource{providerData: data}, model(test.previous),
+				model(test.planned))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got == nil {
+				t.Fatal("the groups of the user were not updated")
+			}
+			if *got != test.want {
+				t.Errorf("groups = %q, want %q", *got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &validateCIDRFunction{}
+)
+
+func NewValidateCIDRFunction() function.Function {
+	return &validateCIDRFunction{}
+}
+
+type validateCIDRFunction struct{}
+
+// cidrInfo is the normalized form of a validated CIDR.
+type cidrInfo struct {
+	Address      string `tfsdk:"address"`
+	CIDR         string `tfsdk:"cidr"`
+	Network      string `tfsdk:"network"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+	Version      int64  `tfsdk:"version"`
+}
+
+func (f *validateCIDRFunction) Metadata(_ context.Context, req function.MetadataRequest,
+	resp *function.MetadataResponse) {
+
+	resp.Name = "validate_cidr"
+}
+
+func (f *validateCIDRFunction) Definition(_ context.Context, req function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+
+	resp.Definition = function.Definition{
+		Summary: "Validates and normalizes an IPv4 or IPv6 CIDR",
+		Description: "Validates that the given string is an IPv4 or IPv6 address in CIDR notation as expected by " +
+			"the firewall and cloud-init ipconfig options and, when a host is given, that the host falls within " +
+			"it. Returns an object with the address, cidr, network, prefix_length and version attributes.",
+		MarkdownDescription: "Validates that the given string is an IPv4 or IPv6 address in CIDR notation as " +
+			"expected by the firewall and cloud-init `ipconfig` options and, when a host is given, that the host " +
+			"falls within it. Returns an object with the `address`, `cidr`, `network`, `prefix_length` and " +
+			"`version` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "Address in CIDR notation (eg: 192.168.1.10/24)",
+			},
+			function.StringParameter{
+				Name:           "host",
+				Description:    "Optional host address which must fall within the CIDR",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"address":       types.StringType,
+				"cidr":          types.StringType,
+				"network":       types.StringType,
+				"prefix_length": types.Int64Type,
+				"version":       types.Int64Type,
+			},
+		},
+	}
+}
+
+func (f *validateCIDRFunction) Run(ctx context.Context, req function.RunRequest,
+	resp *function.RunResponse) {
+
+	var cidr string
+	var host *string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &host))
+	if resp.Error != nil {
+		return
+	}
+
+	info, prefix, err := parseCIDR(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if host != nil {
+		addr, err := netip.ParseAddr(strings.TrimSpace(*host))
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("'%s' is not a valid IP address", *host))
+			return
+		}
+		if addr.Is4() != prefix.Addr().Is4() {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+				"the host '%s' is not of the same address family as the CIDR '%s'", *host, cidr))
+			return
+		}
+		if !prefix.Contains(addr) {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+				"the host '%s' does not fall within the network %s", *host, info.Network))
+			return
+		}
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, info))
+}
+
+// parseCIDR parses an IPv4 or IPv6 address in CIDR notation and returns its normalized form along with the
+// parsed prefix.
+func parseCIDR(cidr string) (cidrInfo, netip.Prefix, error) {
+	value := strings.TrimSpace(cidr)
+	if !strings.Contains(value, "/") {
+		return cidrInfo{}, netip.Prefix{}, fmt.Errorf("'%s' is missing a prefix length (eg: /24)", cidr)
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return cidrInfo{}, netip.Prefix{}, fmt.Errorf("'%s' is not a valid CIDR: %s", cidr,
+			strings.TrimPrefix(err.Error(), fmt.Sprintf("netip.ParsePrefix(%q): ", value)))
+	}
+	if prefix.Addr().Is4In6() {
+		return cidrInfo{}, netip.Prefix{}, fmt.Errorf(
+			"'%s' is an IPv4-mapped IPv6 address which is not supported; use the IPv4 form instead", cidr)
+	}
+
+	info := cidrInfo{
+		Address:      prefix.Addr().String(),
+		CIDR:         prefix.String(),
+		Network:      prefix.Masked().String(),
+		PrefixLength: int64(prefix.Bits()),
+		Version:      6,
+	}
+	if prefix.Addr().Is4() {
+		info.Version = 4
+	}
+	return info, prefix, nil
+}
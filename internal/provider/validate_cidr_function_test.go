@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseCIDR(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		want    cidrInfo
+		wantErr string
+	}{
+		{
+			cidr: "192.168.1.10/24",
+			want: cidrInfo{
+				Address:      "192.168.1.10",
+				CIDR:         "192.168.1.10/24",
+				Network:      "192.168.1.0/24",
+				PrefixLength: 24,
+				Version:      4,
+			},
+		},
+		{
+			cidr: " 10.0.0.0/8 ",
+			want: cidrInfo{Address: "10.0.0.0", CIDR: "10.0.0.0/8", Network: "10.0.0.0/8", PrefixLength: 8, Version: 4},
+		},
+		{
+			cidr: "2001:DB8:0:0::5/64",
+			want: cidrInfo{
+				Address:      "2001:db8::5",
+				CIDR:         "2001:db8::5/64",
+				Network:      "2001:db8::/64",
+				PrefixLength: 64,
+				Version:      6,
+			},
+		},
+		{cidr: "192.168.1.10", wantErr: "is missing a prefix length"},
+		{cidr: "2001:db8::5", wantErr: "is missing a prefix length"},
+		{cidr: "192.168.1.10/33", wantErr: "is not a valid CIDR"},
+		{cidr: "2001:db8::5/129", wantErr: "is not a valid CIDR"},
+		{cidr: "192.168.1/24", wantErr: "is not a valid CIDR"},
+		{cidr: "not-a-cidr/24", wantErr: "is not a valid CIDR"},
+		{cidr: "::ffff:10.0.0.5/120", wantErr: "IPv4-mapped IPv6 address"},
+	}
+	for _, test := range tests {
+		t.Run(test.cidr, func(t *testing.T) {
+			got, _, err := parseCIDR(test.cidr)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("parseCIDR(%q) error = %v, want an error containing %q", test.cidr, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseCIDR(%q) unexpected error: %v", test.cidr, err)
+			}
+			if got != test.want {
+				t.Errorf("parseCIDR(%q) = %+v, want %+v", test.cidr, got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidateCIDRFunctionRunHost(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		host    types.String
+		wantErr string
+	}{
+		{cidr: "10.0.0.5/24", host: types.StringNull()},
+		{cidr: "10.0.0.5/24", host: types.StringValue("10.0.0.1")},
+		{cidr: "2001:db8::5/64", host: types.StringValue("2001:db8::1")},
+		{cidr: "10.0.0.5/24", host: types.StringValue("10.0.1.1"), wantErr: "does not fall within the network"},
+		{cidr: "2001:db8::5/64", host: types.StringValue("2001:db9::1"), wantErr: "does not fall within the network"},
+		{cidr: "10.0.0.5/24", host: types.StringValue("2001:db8::1"), wantErr: "not of the same address family"},
+		{cidr: "10.0.0.5/24", host: types.StringValue("10.0.0"), wantErr: "is not a valid IP address"},
+	}
+	for _, test := range tests {
+		t.Run(test.cidr+" "+test.host.String(), func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(test.cidr), test.host}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(map[string]attr.Type{
+				"address":       types.StringType,
+				"cidr":          types.StringType,
+				"network":       types.StringType,
+				"prefix_length": types.Int64Type,
+				"version":       types.Int64Type,
+			}))}
+			(&validateCIDRFunction{}).Run(context.Background(), req, &resp)
+			if test.wantErr != "" {
+				if resp.Error == nil || !strings.Contains(resp.Error.Error(), test.wantErr) {
+					t.Fatalf("Run() error = %v, want an error containing %q", resp.Error, test.wantErr)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run() unexpected error: %v", resp.Error)
+			}
+		})
+	}
+}
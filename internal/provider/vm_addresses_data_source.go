@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmAddressesDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmAddressesDataSource{}
+)
+
+func NewVMAddressesDataSource() datasource.DataSource {
+	return &vmAddressesDataSource{}
+}
+
+type vmAddressesDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmAddressesDataSourceModel struct {
+	Data   *vmAddressesDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmAddressesDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmAddressesDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type vmAddressesDataSourceDataModel struct {
+	AgentAvailable types.Bool                            `tfsdk:"agent_available"`
+	Interfaces     []vmAddressesDataSourceInterfaceModel `tfsdk:"interfaces"`
+}
+
+type vmAddressesDataSourceInterfaceModel struct {
+	Bridge          types.String   `tfsdk:"bridge"`
+	HardwareAddress types.String   `tfsdk:"mac"`
+	Interface       types.String   `tfsdk:"interface"`
+	IPAddresses     []types.String `tfsdk:"ip_addresses"`
+}
+
+func (d *vmAddressesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmAddressesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_addresses"
+}
+
+func (d *vmAddressesDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the configured network interfaces of a VM together with the IP addresses the QEMU " +
+			"guest agent reports for them. Interfaces of VMs which are stopped or have no agent are returned " +
+			"without IP addresses.",
+		MarkdownDescription: "Retrieves the configured network interfaces of a VM together with the IP addresses " +
+			"the QEMU guest agent reports for them. Interfaces of VMs which are stopped or have no agent are " +
+			"returned without IP addresses.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"agent_available": schema.BoolAttribute{
+						Description:         "Whether or not the QEMU guest agent reported the VM's IP addresses",
+						MarkdownDescription: "Whether or not the QEMU guest agent reported the VM's IP addresses",
+						Computed:            true,
+					},
+					"interfaces": schema.ListNestedAttribute{
+						Description:         "Network interfaces of the VM, ordered by their index",
+						MarkdownDescription: "Network interfaces of the VM, ordered by their index",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"bridge": schema.StringAttribute{
+									Computed: true,
+								},
+								"interface": schema.StringAttribute{
+									Description:         "Name of the network interface (eg: net0)",
+									MarkdownDescription: "Name of the network interface (eg: `net0`)",
+									Computed:            true,
+								},
+								"ip_addresses": schema.ListAttribute{
+									Description: "IP addresses the guest agent reports for the interface's MAC " +
+										"address; empty if the agent is unavailable",
+									MarkdownDescription: "IP addresses the guest agent reports for the interface's " +
+										"MAC address; empty if the agent is unavailable",
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"mac": schema.StringAttribute{
+									Description:         "Upper-case MAC address of the network interface",
+									MarkdownDescription: "Upper-case MAC address of the network interface",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmAddressesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmAddressesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID and node are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the VM addresses.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM "+
+				"addresses or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required",
+			"You must specify a VM ID to retrieve the VM addresses.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+
+	// query for the VM configuration and, if the guest agent can answer, the guest's network interfaces
+	vm := d.providerData.virtualMachine(ctx, nodeName, vmID, &resp.Diagnostics)
+	if vm == nil {
+		return
+	}
+	rawConfig, err := d.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+	state := vmAddressesDataSourceModel{
+		Data: &vmAddressesDataSourceDataModel{
+			AgentAvailable: types.BoolValue(false),
+			Interfaces:     []vmAddressesDataSourceInterfaceModel{},
+		},
+		Filter: config.Filter,
+	}
+	addresses := map[string][]types.String{}
+	if vm.Status == "running" && agentEnabled(rawConfig) {
+		ifaces, err := d.providerData.agentNetworkInterfaces(ctx, nodeName, vmID)
+		if err != nil {
+			tflog.Warn(ctx, "QEMU guest agent is unavailable", map[string]any{
+				"vm_id": vmID,
+				"error": err.Error(),
+			})
+		} else {
+			state.Data.AgentAvailable = types.BoolValue(true)
+			for _, iface := range ifaces {
+				if iface == nil || iface.HardwareAddress == "" {
+					continue
+				}
+				mac := strings.ToUpper(iface.HardwareAddress)
+				for _, address := range iface.IPAddresses {
+					if address != nil && address.IPAddress != "" {
+						addresses[mac] = append(addresses[mac], types.StringValue(address.IPAddress))
+					}
+				}
+			}
+		}
+	}
+
+	// map the configured network interfaces to the model
+	nets := map[string]string{}
+	for key := range rawConfig {
+		if prefix, index := splitConfigKey(key); prefix == "net" && index >= 0 {
+			nets[key] = configString(rawConfig, key).ValueString()
+		}
+	}
+	for _, name := range sortedConfigKeys(nets) {
+		if nets[name] == "" {
+			continue
+		}
+		properties := normalizeNetConfig(nets[name])
+		model := vmAddressesDataSourceInterfaceModel{
+			Bridge:          propertyString(properties, "bridge"),
+			HardwareAddress: propertyString(properties, "macaddr"),
+			Interface:       types.StringValue(name),
+			IPAddresses:     []types.String{},
+		}
+		if ips, ok := addresses[properties["macaddr"]]; ok {
+			model.IPAddresses = ips
+		}
+		state.Data.Interfaces = append(state.Data.Interfaces, model)
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
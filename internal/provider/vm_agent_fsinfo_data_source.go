@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmAgentFSInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmAgentFSInfoDataSource{}
+)
+
+func NewVMAgentFSInfoDataSource() datasource.DataSource {
+	return &vmAgentFSInfoDataSource{}
+}
+
+type vmAgentFSInfoDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmAgentFSInfoDataSourceModel struct {
+	Data   *vmAgentFSInfoDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmAgentFSInfoDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmAgentFSInfoDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type vmAgentFSInfoDataSourceDataModel struct {
+	AgentAvailable types.Bool                               `tfsdk:"agent_available"`
+	Filesystems    []vmAgentFSInfoDataSourceFilesystemModel `tfsdk:"filesystems"`
+}
+
+type vmAgentFSInfoDataSourceFilesystemModel struct {
+	Disk       types.String `tfsdk:"disk"`
+	Mountpoint types.String `tfsdk:"mountpoint"`
+	Name       types.String `tfsdk:"name"`
+	TotalBytes types.Int64  `tfsdk:"total_bytes"`
+	Type       types.String `tfsdk:"type"`
+	UsedBytes  types.Int64  `tfsdk:"used_bytes"`
+}
+
+func (d *vmAgentFSInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmAgentFSInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_agent_fsinfo"
+}
+
+func (d *vmAgentFSInfoDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"agent_available": schema.BoolAttribute{
+						Description:         "Whether or not the QEMU guest agent responded to the requests",
+						MarkdownDescription: "Whether or not the QEMU guest agent responded to the requests",
+						Computed:            true,
+					},
+					"filesystems": schema.ListNestedAttribute{
+						Description:         "Filesystems mounted inside the guest, sorted by mount point",
+						MarkdownDescription: "Filesystems mounted inside the guest, sorted by mount point",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"disk": schema.StringAttribute{
+									Description: "Device of the first disk backing the filesystem (eg: " +
+										"/dev/sda); null if the agent does not report it",
+									MarkdownDescription: "Device of the first disk backing the filesystem (eg: " +
+										"`/dev/sda`); null if the agent does not report it",
+									Computed: true,
+								},
+								"mountpoint": schema.StringAttribute{
+									Computed: true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"total_bytes": schema.Int64Attribute{
+									Description: "Total size of the filesystem in bytes; null if the agent " +
+										"does not report it",
+									MarkdownDescription: "Total size of the filesystem in bytes; null if the agent " +
+										"does not report it",
+									Computed: true,
+								},
+								"type": schema.StringAttribute{
+									Computed: true,
+								},
+								"used_bytes": schema.Int64Attribute{
+									Description: "Space used on the filesystem in bytes; null if the agent " +
+										"does not report it",
+									MarkdownDescription: "Space used on the filesystem in bytes; null if the agent " +
+										"does not report it",
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmAgentFSInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmAgentFSInfoDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID and node are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the VM guest filesystem information.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM guest "+
+				"filesystem information or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required",
+			"You must specify a VM ID to retrieve the VM guest filesystem information.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+
+	// query the guest agent
+	vm := d.providerData.virtualMachine(ctx, nodeName, vmID, &resp.Diagnostics)
+	if vm == nil {
+		return
+	}
+	state := vmAgentFSInfoDataSourceModel{
+		Data: &vmAgentFSInfoDataSourceDataModel{
+			AgentAvailable: types.BoolValue(false),
+			Filesystems:    []vmAgentFSInfoDataSourceFilesystemModel{},
+		},
+		Filter: config.Filter,
+	}
+	filesystems, err := d.providerData.agentFSInfo(ctx, nodeName, vmID)
+	if err == nil {
+		state.Data.AgentAvailable = types.BoolValue(true)
+		slices.SortFunc(filesystems, func(a, b agentFilesystem) int {
+			return cmp.Or(cmp.Compare(a.Mountpoint, b.Mountpoint), cmp.Compare(a.Name, b.Name))
+		})
+		for _, fs := range filesystems {
+			model := vmAgentFSInfoDataSourceFilesystemModel{
+				Disk:       types.StringNull(),
+				Mountpoint: types.StringValue(fs.Mountpoint),
+				Name:       types.StringValue(fs.Name),
+				TotalBytes: types.Int64PointerValue(fs.TotalBytes),
+				Type:       types.StringValue(fs.Type),
+				UsedBytes:  types.Int64PointerValue(fs.UsedBytes),
+			}
+			if len(fs.Disks) > 0 && fs.Disks[0].Dev != "" {
+				model.Disk = types.StringValue(fs.Disks[0].Dev)
+			}
+			state.Data.Filesystems = append(state.Data.Filesystems, model)
+		}
+	}
+	if err != nil {
+		tflog.Warn(ctx, "QEMU guest agent is unavailable", map[string]any{
+			"vm_id":  vmID,
+			"status": vm.Status,
+			"error":  err.Error(),
+		})
+		resp.Diagnostics.AddWarning(
+			"QEMU Guest Agent Unavailable",
+			fmt.Sprintf("The QEMU guest agent for the virtual machine with the ID '%d' (status: %s) did not "+
+				"respond, so no filesystem information is available:\n\t%s", vmID, vm.Status, err.Error()),
+		)
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
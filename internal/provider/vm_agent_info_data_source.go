@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmAgentInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmAgentInfoDataSource{}
+)
+
+func NewVMAgentInfoDataSource() datasource.DataSource {
+	return &vmAgentInfoDataSource{}
+}
+
+type vmAgentInfoDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmAgentInfoDataSourceModel struct {
+	Data   *vmAgentInfoDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmAgentInfoDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmAgentInfoDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type vmAgentInfoDataSourceDataModel struct {
+	AgentAvailable types.Bool                        `tfsdk:"agent_available"`
+	Hostname       types.String                      `tfsdk:"hostname"`
+	OSInfo         *vmAgentInfoDataSourceOSInfoModel `tfsdk:"os_info"`
+}
+
+type vmAgentInfoDataSourceOSInfoModel struct {
+	ID            types.String `tfsdk:"id"`
+	KernelRelease types.String `tfsdk:"kernel_release"`
+	KernelVersion types.String `tfsdk:"kernel_version"`
+	Machine       types.String `tfsdk:"machine"`
+	Name          types.String `tfsdk:"name"`
+	PrettyName    types.String `tfsdk:"pretty_name"`
+	Version       types.String `tfsdk:"version"`
+	VersionID     types.String `tfsdk:"version_id"`
+}
+
+func (d *vmAgentInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmAgentInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_agent_info"
+}
+
+func (d *vmAgentInfoDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"agent_available": schema.BoolAttribute{
+						Description:         "Whether or not the QEMU guest agent responded to the requests",
+						MarkdownDescription: "Whether or not the QEMU guest agent responded to the requests",
+						Computed:            true,
+					},
+					"hostname": schema.StringAttribute{
+						Computed: true,
+					},
+					"os_info": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Computed: true,
+							},
+							"kernel_release": schema.StringAttribute{
+								Computed: true,
+							},
+							"kernel_version": schema.StringAttribute{
+								Computed: true,
+							},
+							"machine": schema.StringAttribute{
+								Computed: true,
+							},
+							"name": schema.StringAttribute{
+								Computed: true,
+							},
+							"pretty_name": schema.StringAttribute{
+								Computed: true,
+							},
+							"version": schema.StringAttribute{
+								Computed: true,
+							},
+							"version_id": schema.StringAttribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmAgentInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmAgentInfoDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID and node are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the VM guest agent information.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM guest "+
+				"agent information or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the VM guest agent information.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+
+	// query the guest agent
+	vm := d.providerData.virtualMachine(ctx, nodeName, vmID, &resp.Diagnostics)
+	if vm == nil {
+		return
+	}
+	state := vmAgentInfoDataSourceModel{
+		Data: &vmAgentInfoDataSourceDataModel{
+			AgentAvailable: types.BoolValue(false),
+			Hostname:       types.StringNull(),
+		},
+		Filter: config.Filter,
+	}
+	hostname, err := d.providerData.agentHostname(ctx, nodeName, vmID)
+	if err == nil {
+		var info *proxmox.AgentOsInfo
+		info, err = d.providerData.agentOSInfo(ctx, nodeName, vmID)
+		if err == nil {
+			state.Data.AgentAvailable = types.BoolValue(true)
+			state.Data.Hostname = types.StringValue(hostname)
+			state.Data.OSInfo = &vmAgentInfoDataSourceOSInfoModel{
+				ID:            types.StringValue(info.ID),
+				KernelRelease: types.StringValue(info.KernelRelease),
+				KernelVersion: types.StringValue(info.KernelVersion),
+				Machine:       types.StringValue(info.Machine),
+				Name:          types.StringValue(info.Name),
+				PrettyName:    types.StringValue(info.PrettyName),
+				Version:       types.StringValue(info.Version),
+				VersionID:     types.StringValue(info.VersionID),
+			}
+		}
+	}
+	if err != nil {
+		tflog.Warn(ctx, "QEMU guest agent is unavailable", map[string]any{
+			"vm_id":  vmID,
+			"status": vm.Status,
+			"error":  err.Error(),
+		})
+		resp.Diagnostics.AddWarning(
+			"QEMU Guest Agent Unavailable",
+			fmt.Sprintf("The QEMU guest agent for the virtual machine with the ID '%d' (status: %s) did not "+
+				"respond, so no guest information is available:\n\t%s", vmID, vm.Status, err.Error()),
+		)
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -30,33 +33,124 @@ type vmConfigDataSource struct {
 type vmConfigDataSourceModel struct {
 	Data   *vmConfigDataSourceDataModel   `tfsdk:"data"`
 	Filter *vmConfigDataSourceFilterModel `tfsdk:"filter"`
+	Found  types.Bool                     `tfsdk:"found"`
 }
 
 type vmConfigDataSourceFilterModel struct {
-	NodeName types.String `tfsdk:"node_name"`
-	VMID     types.Int32  `tfsdk:"vm_id"`
+	AllowMissing           types.Bool     `tfsdk:"allow_missing"`
+	AssumeFirewallDefault  types.Bool     `tfsdk:"assume_firewall_default"`
+	NetworkInterfaceFields []types.String `tfsdk:"network_interface_fields"`
+	NodeName               types.String   `tfsdk:"node_name"`
+	RequireStatus          types.String   `tfsdk:"require_status"`
+	ResolveBridges         types.Bool     `tfsdk:"resolve_bridges"`
+	VMID                   types.Int32    `tfsdk:"vm_id"`
 }
 
 type vmConfigDataSourceDataModel struct {
+	ACPI              types.Bool                                `tfsdk:"acpi"`
+	Affinity          types.String                              `tfsdk:"affinity"`
+	AffinityCPUs      []types.Int64                             `tfsdk:"affinity_cpus"`
+	Args              types.String                              `tfsdk:"args"`
+	BootDisk          types.String                              `tfsdk:"boot_disk"`
+	BootOrder         []types.String                            `tfsdk:"boot_order"`
+	CDROMDrives       []vmConfigDataSourceCDROMDriveModel       `tfsdk:"cdrom_drives"`
+	Description       types.String                              `tfsdk:"description"`
+	Disks             []vmConfigDataSourceDiskModel             `tfsdk:"disks"`
+	HasCustomArgs     types.Bool                                `tfsdk:"has_custom_args"`
+	Hookscript        types.String                              `tfsdk:"hookscript"`
+	Hotplug           []types.String                            `tfsdk:"hotplug"`
+	HotplugCPU        types.Bool                                `tfsdk:"hotplug_cpu"`
+	HotplugDisk       types.Bool                                `tfsdk:"hotplug_disk"`
+	HotplugMemory     types.Bool                                `tfsdk:"hotplug_memory"`
+	HotplugNetwork    types.Bool                                `tfsdk:"hotplug_network"`
+	HotplugUSB        types.Bool                                `tfsdk:"hotplug_usb"`
+	Hugepages         types.String                              `tfsdk:"hugepages"`
+	IPConfigs         []vmConfigDataSourceIPConfigModel         `tfsdk:"ip_configs"`
+	KeepHugepages     types.Bool                                `tfsdk:"keephugepages"`
+	KVM               types.Bool                                `tfsdk:"kvm"`
+	LocalTime         types.Bool                                `tfsdk:"localtime"`
 	Name              types.String                              `tfsdk:"name"`
 	Node              types.String                              `tfsdk:"node"`
 	NetworkInterfaces []vmConfigDataSourceNetworkInterfaceModel `tfsdk:"network_interfaces"`
+	NUMANodes         []vmConfigDataSourceNUMANodeModel         `tfsdk:"numa_nodes"`
+	PrimaryMAC        types.String                              `tfsdk:"primary_mac"`
+	Reboot            types.Bool                                `tfsdk:"reboot"`
+	RequiresReboot    types.Bool                                `tfsdk:"requires_reboot"`
 	Status            types.String                              `tfsdk:"status"`
+	Tablet            types.Bool                                `tfsdk:"tablet"`
+	TotalDiskBytes    types.Int64                               `tfsdk:"total_disk_bytes"`
 	VMID              types.Int32                               `tfsdk:"vm_id"`
 }
 
+type vmConfigDataSourceCDROMDriveModel struct {
+	Interface types.String `tfsdk:"interface"`
+	ISO       types.String `tfsdk:"iso"`
+	RawConfig types.String `tfsdk:"raw_config"`
+}
+
+type vmConfigDataSourceDiskModel struct {
+	Cache     types.String `tfsdk:"cache"`
+	Format    types.String `tfsdk:"format"`
+	FreeBytes types.Int64  `tfsdk:"free_bytes"`
+	Interface types.String `tfsdk:"interface"`
+	IsBoot    types.Bool   `tfsdk:"is_boot"`
+	RawConfig types.String `tfsdk:"raw_config"`
+	SizeBytes types.Int64  `tfsdk:"size_bytes"`
+	Storage   types.String `tfsdk:"storage"`
+	UsedBytes types.Int64  `tfsdk:"used_bytes"`
+	Volume    types.String `tfsdk:"volume"`
+}
+
+type vmConfigDataSourceIPConfigModel struct {
+	IPv4Address types.String `tfsdk:"ipv4_address"`
+	IPv4Gateway types.String `tfsdk:"ipv4_gateway"`
+	IPv6Address types.String `tfsdk:"ipv6_address"`
+	IPv6Gateway types.String `tfsdk:"ipv6_gateway"`
+	Name        types.String `tfsdk:"name"`
+	RawConfig   types.String `tfsdk:"raw_config"`
+}
+
+type vmConfigDataSourceNUMANodeModel struct {
+	CPUs      []types.Int64 `tfsdk:"cpus"`
+	HostNodes []types.Int64 `tfsdk:"host_nodes"`
+	Memory    types.Int64   `tfsdk:"memory"`
+	Name      types.String  `tfsdk:"name"`
+	Policy    types.String  `tfsdk:"policy"`
+	RawConfig types.String  `tfsdk:"raw_config"`
+}
+
+// defaultLegacyBoot is the legacy boot option used when none is set: disk, then CD-ROM, then network.
+const defaultLegacyBoot = "cdn"
+
+// defaultHotplug are the device categories which can be hot-plugged when the hotplug option is not set.
+var defaultHotplug = []string{"disk", "network", "usb"}
+
+// nicMTUInherit is the special MTU value which makes a network interface use the MTU of its bridge.
+const nicMTUInherit = 1
+
+// vmConfigNetworkInterfaceFields are the attribute names of a network interface which may be selected with the
+// network_interface_fields filter.
+var vmConfigNetworkInterfaceFields = []string{
+	"bridge", "bridge_vlan_aware", "firewall", "firewall_configured", "is_locally_administered", "link_down",
+	"mac_addr", "model", "mtu", "mtu_inherit", "queues", "rate", "raw_config", "tag", "trunks",
+}
+
 type vmConfigDataSourceNetworkInterfaceModel struct {
-	Bridge          types.String  `tfsdk:"bridge"`
-	Firewall        types.Bool    `tfsdk:"firewall"`
-	HardwareAddress types.String  `tfsdk:"mac_addr"`
-	LinkDown        types.Bool    `tfsdk:"link_down"`
-	Model           types.String  `tfsdk:"model"`
-	MTU             types.Int32   `tfsdk:"mtu"`
-	Queues          types.Int32   `tfsdk:"queues"`
-	Rate            types.Int32   `tfsdk:"rate"`
-	RawConfig       types.String  `tfsdk:"raw_config"`
-	Tag             types.Int32   `tfsdk:"tag"`
-	Trunks          []types.Int32 `tfsdk:"trunks"`
+	Bridge                types.String  `tfsdk:"bridge"`
+	BridgeVLANAware       types.Bool    `tfsdk:"bridge_vlan_aware"`
+	Firewall              types.Bool    `tfsdk:"firewall"`
+	FirewallConfigured    types.Bool    `tfsdk:"firewall_configured"`
+	HardwareAddress       types.String  `tfsdk:"mac_addr"`
+	IsLocallyAdministered types.Bool    `tfsdk:"is_locally_administered"`
+	LinkDown              types.Bool    `tfsdk:"link_down"`
+	Model                 types.String  `tfsdk:"model"`
+	MTU                   types.Int32   `tfsdk:"mtu"`
+	MTUInherit            types.Bool    `tfsdk:"mtu_inherit"`
+	Queues                types.Int32   `tfsdk:"queues"`
+	Rate                  types.Int32   `tfsdk:"rate"`
+	RawConfig             types.String  `tfsdk:"raw_config"`
+	Tag                   types.Int32   `tfsdk:"tag"`
+	Trunks                []types.Int32 `tfsdk:"trunks"`
 }
 
 func (d *vmConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
@@ -95,6 +189,237 @@ func (d *vmConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequ
 			"data": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
+					"acpi": schema.BoolAttribute{
+						Description:         "Whether or not ACPI is enabled; null when unset (enabled by default)",
+						MarkdownDescription: "Whether or not ACPI is enabled; null when unset (enabled by default)",
+						Computed:            true,
+					},
+					"affinity": schema.StringAttribute{
+						Description:         "Host CPUs the VM is pinned to as configured (eg: 0-3); null when unset",
+						MarkdownDescription: "Host CPUs the VM is pinned to as configured (eg: `0-3`); null when unset",
+						Computed:            true,
+					},
+					"affinity_cpus": schema.ListAttribute{
+						Description:         "Sorted list of host CPU IDs the VM is pinned to; null when unset",
+						MarkdownDescription: "Sorted list of host CPU IDs the VM is pinned to; null when unset",
+						Computed:            true,
+						ElementType:         types.Int64Type,
+					},
+					"args": schema.StringAttribute{
+						Description:         "Raw arguments passed to QEMU exactly as configured; null when unset",
+						MarkdownDescription: "Raw arguments passed to QEMU exactly as configured; null when unset",
+						Computed:            true,
+					},
+					"boot_disk": schema.StringAttribute{
+						Description: "First data disk in the boot order (eg: scsi0); null if the boot order " +
+							"contains no data disk",
+						MarkdownDescription: "First data disk in the boot order (eg: `scsi0`); null if the boot " +
+							"order contains no data disk",
+						Computed: true,
+					},
+					"boot_order": schema.ListAttribute{
+						Description: "Devices the VM tries to boot from in order (eg: scsi0, ide2, net0); for the " +
+							"legacy boot format only the bootdisk is listed",
+						MarkdownDescription: "Devices the VM tries to boot from in order (eg: `scsi0`, `ide2`, " +
+							"`net0`); for the legacy boot format only the `bootdisk` is listed",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"cdrom_drives": schema.ListNestedAttribute{
+						Description:         "CD/DVD drives of the VM (drives configured with media=cdrom)",
+						MarkdownDescription: "CD/DVD drives of the VM (drives configured with `media=cdrom`)",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"interface": schema.StringAttribute{
+									Description:         "Name of the drive (eg: ide2)",
+									MarkdownDescription: "Name of the drive (eg: `ide2`)",
+									Computed:            true,
+								},
+								"iso": schema.StringAttribute{
+									Description: "Volume ID of the inserted ISO image (eg: local:iso/debian.iso), " +
+										"none for an empty drive or cdrom for the host's physical drive",
+									MarkdownDescription: "Volume ID of the inserted ISO image (eg: " +
+										"`local:iso/debian.iso`), `none` for an empty drive or `cdrom` for the " +
+										"host's physical drive",
+									Computed: true,
+								},
+								"raw_config": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"disks": schema.ListNestedAttribute{
+						Description:         "Data disks of the VM; CD/DVD drives are listed in cdrom_drives instead",
+						MarkdownDescription: "Data disks of the VM; CD/DVD drives are listed in `cdrom_drives` instead",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"cache": schema.StringAttribute{
+									Computed: true,
+								},
+								"format": schema.StringAttribute{
+									Computed: true,
+								},
+								"free_bytes": schema.Int64Attribute{
+									Description: "Free space in bytes of the guest filesystems on the disk as " +
+										"reported by the QEMU guest agent; null when unavailable or when the disk " +
+										"has no serial number to match it with",
+									MarkdownDescription: "Free space in bytes of the guest filesystems on the " +
+										"disk as reported by the QEMU guest agent; null when unavailable or when " +
+										"the disk has no `serial` to match it with",
+									Computed: true,
+								},
+								"interface": schema.StringAttribute{
+									Description:         "Disk bus and index (eg: scsi0)",
+									MarkdownDescription: "Disk bus and index (eg: `scsi0`)",
+									Computed:            true,
+								},
+								"is_boot": schema.BoolAttribute{
+									Description:         "Whether or not the disk is part of the boot order",
+									MarkdownDescription: "Whether or not the disk is part of the `boot_order`",
+									Computed:            true,
+								},
+								"raw_config": schema.StringAttribute{
+									Computed: true,
+								},
+								"size_bytes": schema.Int64Attribute{
+									Computed: true,
+								},
+								"storage": schema.StringAttribute{
+									Computed: true,
+								},
+								"used_bytes": schema.Int64Attribute{
+									Description: "Used space in bytes of the guest filesystems on the disk as " +
+										"reported by the QEMU guest agent; null when unavailable or when the disk " +
+										"has no serial number to match it with",
+									MarkdownDescription: "Used space in bytes of the guest filesystems on the " +
+										"disk as reported by the QEMU guest agent; null when unavailable or when " +
+										"the disk has no `serial` to match it with",
+									Computed: true,
+								},
+								"volume": schema.StringAttribute{
+									Description:         "Volume ID (eg: local-lvm:vm-100-disk-0)",
+									MarkdownDescription: "Volume ID (eg: `local-lvm:vm-100-disk-0`)",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"description": schema.StringAttribute{
+						Description:         "Notes shown in the VM's summary; null when unset",
+						MarkdownDescription: "Notes shown in the VM's summary; null when unset",
+						Computed:            true,
+					},
+					"has_custom_args": schema.BoolAttribute{
+						Description: "Whether or not the VM passes custom arguments to QEMU which bypass the " +
+							"options Proxmox VE manages",
+						MarkdownDescription: "Whether or not the VM passes custom arguments to QEMU (`args`) which " +
+							"bypass the options Proxmox VE manages",
+						Computed: true,
+					},
+					"hookscript": schema.StringAttribute{
+						Description:         "Volume ID of the hook script; null when unset",
+						MarkdownDescription: "Volume ID of the hook script; null when unset",
+						Computed:            true,
+					},
+					"hotplug": schema.ListAttribute{
+						Description: "Sorted list of device categories which can be hot-plugged (eg: disk, network, " +
+							"usb); empty when hot-plugging is disabled",
+						MarkdownDescription: "Sorted list of device categories which can be hot-plugged (eg: `disk`, " +
+							"`network`, `usb`); empty when hot-plugging is disabled",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"hotplug_cpu": schema.BoolAttribute{
+						Description:         "Whether or not CPUs can be hot-plugged",
+						MarkdownDescription: "Whether or not CPUs can be hot-plugged",
+						Computed:            true,
+					},
+					"hotplug_disk": schema.BoolAttribute{
+						Description:         "Whether or not disks can be hot-plugged",
+						MarkdownDescription: "Whether or not disks can be hot-plugged",
+						Computed:            true,
+					},
+					"hotplug_memory": schema.BoolAttribute{
+						Description:         "Whether or not memory can be hot-plugged",
+						MarkdownDescription: "Whether or not memory can be hot-plugged",
+						Computed:            true,
+					},
+					"hotplug_network": schema.BoolAttribute{
+						Description:         "Whether or not network interfaces can be hot-plugged",
+						MarkdownDescription: "Whether or not network interfaces can be hot-plugged",
+						Computed:            true,
+					},
+					"hotplug_usb": schema.BoolAttribute{
+						Description:         "Whether or not USB devices can be hot-plugged",
+						MarkdownDescription: "Whether or not USB devices can be hot-plugged",
+						Computed:            true,
+					},
+					"hugepages": schema.StringAttribute{
+						Description:         "Hugepage size in MB (2 or 1024) or 'any'; null when unset",
+						MarkdownDescription: "Hugepage size in MB (`2` or `1024`) or `any`; null when unset",
+						Computed:            true,
+					},
+					"ip_configs": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"ipv4_address": schema.StringAttribute{
+									Description: "IPv4 address in CIDR notation or 'dhcp'; null when IPv4 is " +
+										"not configured",
+									MarkdownDescription: "IPv4 address in CIDR notation or `dhcp`; null when IPv4 " +
+										"is not configured",
+									Computed: true,
+								},
+								"ipv4_gateway": schema.StringAttribute{
+									Description:         "IPv4 gateway; null when no gateway is configured",
+									MarkdownDescription: "IPv4 gateway; null when no gateway is configured",
+									Computed:            true,
+								},
+								"ipv6_address": schema.StringAttribute{
+									Description: "IPv6 address in CIDR notation, 'dhcp' or 'auto'; null when " +
+										"IPv6 is not configured",
+									MarkdownDescription: "IPv6 address in CIDR notation, `dhcp` or `auto`; null " +
+										"when IPv6 is not configured",
+									Computed: true,
+								},
+								"ipv6_gateway": schema.StringAttribute{
+									Description:         "IPv6 gateway; null when no gateway is configured",
+									MarkdownDescription: "IPv6 gateway; null when no gateway is configured",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"raw_config": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"keephugepages": schema.BoolAttribute{
+						Description: "Whether or not hugepages are kept allocated after the VM shuts down; null " +
+							"when unset",
+						MarkdownDescription: "Whether or not hugepages are kept allocated after the VM shuts down; " +
+							"null when unset",
+						Computed: true,
+					},
+					"kvm": schema.BoolAttribute{
+						Description: "Whether or not KVM hardware virtualization is enabled; null when unset " +
+							"(enabled by default)",
+						MarkdownDescription: "Whether or not KVM hardware virtualization is enabled; null when " +
+							"unset (enabled by default)",
+						Computed: true,
+					},
+					"localtime": schema.BoolAttribute{
+						Description: "Whether or not the real time clock is set to local time; null when unset " +
+							"(enabled by default for Windows guests)",
+						MarkdownDescription: "Whether or not the real time clock is set to local time; null when " +
+							"unset (enabled by default for Windows guests)",
+						Computed: true,
+					},
 					"name": schema.StringAttribute{
 						Computed: true,
 					},
@@ -113,10 +438,38 @@ func (d *vmConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequ
 									Computed: true,
 									Optional: true,
 								},
+								"bridge_vlan_aware": schema.BoolAttribute{
+									Description: "Whether or not the bridge is VLAN-aware; null unless " +
+										"resolve_bridges is enabled and the bridge exists on the node",
+									MarkdownDescription: "Whether or not the bridge is VLAN-aware; null unless " +
+										"`resolve_bridges` is enabled and the bridge exists on the node",
+									Computed: true,
+								},
 								"firewall": schema.BoolAttribute{
+									Description: "Whether or not the firewall is enabled on the network interface; " +
+										"null when it is not configured unless assume_firewall_default is enabled",
+									MarkdownDescription: "Whether or not the firewall is enabled on the network " +
+										"interface; null when it is not configured unless " +
+										"`assume_firewall_default` is enabled",
 									Computed: true,
 									Optional: true,
 								},
+								"firewall_configured": schema.BoolAttribute{
+									Description: "Whether or not the firewall option is explicitly set in the " +
+										"configuration of the network interface",
+									MarkdownDescription: "Whether or not the `firewall` option is explicitly set in " +
+										"the configuration of the network interface",
+									Computed: true,
+								},
+								"is_locally_administered": schema.BoolAttribute{
+									Description: "Whether or not the U/L bit of the MAC address is set, meaning it " +
+										"was assigned locally rather than by a manufacturer; null when the MAC " +
+										"address is not set or not a valid unicast address",
+									MarkdownDescription: "Whether or not the U/L bit of the MAC address is set, " +
+										"meaning it was assigned locally rather than by a manufacturer; null when " +
+										"the MAC address is not set or not a valid unicast address",
+									Computed: true,
+								},
 								"link_down": schema.BoolAttribute{
 									Computed: true,
 									Optional: true,
@@ -129,6 +482,13 @@ func (d *vmConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequ
 									Computed: true,
 									Optional: true,
 								},
+								"mtu_inherit": schema.BoolAttribute{
+									Description: "Whether or not the network interface inherits the MTU of its " +
+										"bridge (configured as mtu=1)",
+									MarkdownDescription: "Whether or not the network interface inherits the MTU of " +
+										"its bridge (configured as `mtu=1`)",
+									Computed: true,
+								},
 								"queues": schema.Int32Attribute{
 									Computed: true,
 									Optional: true,
@@ -145,6 +505,10 @@ func (d *vmConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequ
 									Optional: true,
 								},
 								"trunks": schema.ListAttribute{
+									Description: "VLAN trunks passed through the interface, sorted and " +
+										"de-duplicated",
+									MarkdownDescription: "VLAN trunks passed through the interface, sorted and " +
+										"de-duplicated",
 									Computed:    true,
 									ElementType: types.Int32Type,
 									Optional:    true,
@@ -152,9 +516,77 @@ func (d *vmConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequ
 							},
 						},
 					},
+					"numa_nodes": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"cpus": schema.ListAttribute{
+									Description:         "Guest CPU IDs assigned to the NUMA node",
+									MarkdownDescription: "Guest CPU IDs assigned to the NUMA node",
+									Computed:            true,
+									ElementType:         types.Int64Type,
+								},
+								"host_nodes": schema.ListAttribute{
+									Description:         "Host NUMA node IDs backing the guest NUMA node",
+									MarkdownDescription: "Host NUMA node IDs backing the guest NUMA node",
+									Computed:            true,
+									ElementType:         types.Int64Type,
+								},
+								"memory": schema.Int64Attribute{
+									Description:         "Memory assigned to the NUMA node in MB",
+									MarkdownDescription: "Memory assigned to the NUMA node in MB",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"policy": schema.StringAttribute{
+									Computed: true,
+								},
+								"raw_config": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"primary_mac": schema.StringAttribute{
+						Description: "MAC address of the lowest-indexed network interface (usually net0); null if " +
+							"the VM has no network interfaces",
+						MarkdownDescription: "MAC address of the lowest-indexed network interface (usually `net0`); " +
+							"null if the VM has no network interfaces",
+						Computed: true,
+					},
+					"reboot": schema.BoolAttribute{
+						Description: "Whether or not a guest reboot restarts the VM rather than stopping it; null " +
+							"when unset (enabled by default)",
+						MarkdownDescription: "Whether or not a guest reboot restarts the VM rather than stopping " +
+							"it; null when unset (enabled by default)",
+						Computed: true,
+					},
+					"requires_reboot": schema.BoolAttribute{
+						Description: "Whether or not the VM has pending configuration changes which are only " +
+							"applied when it is restarted",
+						MarkdownDescription: "Whether or not the VM has pending configuration changes which are " +
+							"only applied when it is restarted",
+						Computed: true,
+					},
 					"status": schema.StringAttribute{
 						Computed: true,
 					},
+					"tablet": schema.BoolAttribute{
+						Description: "Whether or not the USB tablet pointer device is enabled; null when unset " +
+							"(enabled by default)",
+						MarkdownDescription: "Whether or not the USB tablet pointer device is enabled; null when " +
+							"unset (enabled by default)",
+						Computed: true,
+					},
+					"total_disk_bytes": schema.Int64Attribute{
+						Description: "Sum of the sizes of all disks attached to the VM, excluding EFI and TPM " +
+							"state disks",
+						MarkdownDescription: "Sum of the sizes of all disks attached to the VM, excluding EFI and " +
+							"TPM state disks",
+						Computed: true,
+					},
 					"vm_id": schema.Int32Attribute{
 						Computed: true,
 					},
@@ -163,14 +595,62 @@ func (d *vmConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequ
 			"filter": schema.SingleNestedAttribute{
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
+					"allow_missing": schema.BoolAttribute{
+						Description: "When true, a VM that does not exist results in a null data attribute and " +
+							"found set to false instead of an error",
+						MarkdownDescription: "When `true`, a VM that does not exist results in a null `data` " +
+							"attribute and `found` set to `false` instead of an error",
+						Optional: true,
+					},
+					"assume_firewall_default": schema.BoolAttribute{
+						Description: "When true, network interfaces which do not set the firewall option report it " +
+							"as enabled, which is the default the web UI uses for new network interfaces. Defaults " +
+							"to false, which reports it as null",
+						MarkdownDescription: "When `true`, network interfaces which do not set the `firewall` option " +
+							"report it as enabled, which is the default the web UI uses for new network interfaces. " +
+							"Defaults to `false`, which reports it as null",
+						Optional: true,
+					},
+					"network_interface_fields": schema.ListAttribute{
+						Description: "Network interface attributes to populate (eg: bridge, mac_addr); all other " +
+							"attributes of the network interfaces are null. All attributes are populated when omitted",
+						MarkdownDescription: "Network interface attributes to populate (eg: `bridge`, `mac_addr`); " +
+							"all other attributes of the network interfaces are null. All attributes are populated " +
+							"when omitted",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
 					"node_name": schema.StringAttribute{
-						Required: true,
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"require_status": schema.StringAttribute{
+						Description: "When set, the read fails unless the VM currently has this status " +
+							"(eg: running)",
+						MarkdownDescription: "When set, the read fails unless the VM currently has this status " +
+							"(eg: `running`)",
+						Optional: true,
+					},
+					"resolve_bridges": schema.BoolAttribute{
+						Description: "When true, look up whether the bridge of each network interface is VLAN-aware " +
+							"on the node, which requires an additional API call",
+						MarkdownDescription: "When `true`, look up whether the `bridge` of each network interface is " +
+							"VLAN-aware on the node, which requires an additional API call",
+						Optional: true,
 					},
 					"vm_id": schema.Int32Attribute{
 						Required: true,
 					},
 				},
 			},
+			"found": schema.BoolAttribute{
+				Description:         "Whether or not the VM exists",
+				MarkdownDescription: "Whether or not the VM exists",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -179,6 +659,8 @@ func (d *vmConfigDataSource) Read(ctx context.Context, req datasource.ReadReques
 	resp *datasource.ReadResponse) {
 
 	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
 
 	// read configuration
 	var config vmConfigDataSourceModel
@@ -195,13 +677,14 @@ func (d *vmConfigDataSource) Read(ctx context.Context, req datasource.ReadReques
 		)
 		return
 	}
-	if config.Filter.NodeName.IsNull() || config.Filter.NodeName.IsUnknown() {
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
 		resp.Diagnostics.AddError(
-			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM configuration.",
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM configuration "+
+				"or configure a default node for the provider.",
 		)
 		return
 	}
-	nodeName := config.Filter.NodeName.ValueString()
 	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
 		resp.Diagnostics.AddError(
 			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the VM configuration.",
@@ -209,49 +692,269 @@ func (d *vmConfigDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 	vmID := int(config.Filter.VMID.ValueInt32())
+	var nicFields map[string]bool
+	if config.Filter.NetworkInterfaceFields != nil {
+		nicFields = map[string]bool{}
+		for _, field := range config.Filter.NetworkInterfaceFields {
+			if !slices.Contains(vmConfigNetworkInterfaceFields, field.ValueString()) {
+				resp.Diagnostics.AddError(
+					"Invalid Network Interface Field",
+					fmt.Sprintf("The network interface field '%s' is not valid; it must be one of: %s.",
+						field.ValueString(), strings.Join(vmConfigNetworkInterfaceFields, ", ")),
+				)
+				continue
+			}
+			nicFields[field.ValueString()] = true
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// query for the configuration
-	node, err := d.providerData.client.Node(ctx, nodeName)
+	var vm *proxmox.VirtualMachine
+	if config.Filter.AllowMissing.ValueBool() {
+		vm = d.providerData.virtualMachineIfExists(ctx, nodeName, vmID, &resp.Diagnostics)
+	} else {
+		vm = d.providerData.virtualMachine(ctx, nodeName, vmID, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if vm == nil {
+		state := vmConfigDataSourceModel{
+			Filter: config.Filter,
+			Found:  types.BoolValue(false),
+		}
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	tflog.Info(ctx, "located VM", map[string]any{"vm": vm})
+	rawConfig, err := d.providerData.rawVMConfig(ctx, nodeName, vmID)
 	if err != nil {
-		tflog.Error(ctx, "failed to locate cluster node", map[string]any{
-			"node_name": nodeName,
-			"error":     err.Error(),
-		})
 		resp.Diagnostics.AddError(
-			"Proxmox VE API: Failed to Locate Node",
-			fmt.Sprintf("Failed to locate the cluster node '%s':\n\t%s", nodeName, err.Error()),
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+	if requiredStatus := config.Filter.RequireStatus.ValueString(); requiredStatus != "" && vm.Status != requiredStatus {
+		resp.Diagnostics.AddError(
+			"Unexpected VM Status",
+			fmt.Sprintf("The virtual machine with the ID '%d' has the status '%s' but '%s' is required.",
+				vmID, vm.Status, requiredStatus),
 		)
 		return
 	}
-	vm, err := node.VirtualMachine(ctx, vmID)
+	requiresReboot, err := d.providerData.vmHasPendingChanges(ctx, nodeName, vmID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Proxmox VE API: Failed to Retrieve VM",
-			fmt.Sprintf("Failed to retrieve the virtual machine with the ID '%d':\n\t%s", vmID, err.Error()),
+			"Proxmox VE API: Failed to Retrieve VM Pending Config",
+			fmt.Sprintf("Failed to retrieve the pending configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
 		)
 		return
 	}
-	tflog.Info(ctx, "located VM", map[string]any{"vm": vm})
 
 	// map the response to the model
+	hotplug := parseHotplug(configString(rawConfig, "hotplug").ValueString())
+	bootOrder := parseBootOrder(configString(rawConfig, "boot").ValueString(),
+		configString(rawConfig, "bootdisk").ValueString())
 	state := vmConfigDataSourceModel{
 		Data: &vmConfigDataSourceDataModel{
+			ACPI:              configBool(rawConfig, "acpi", types.BoolNull()),
+			Affinity:          types.StringNull(),
+			Args:              types.StringNull(),
+			BootDisk:          types.StringNull(),
+			BootOrder:         []types.String{},
+			CDROMDrives:       []vmConfigDataSourceCDROMDriveModel{},
+			Description:       configString(rawConfig, "description"),
+			Disks:             []vmConfigDataSourceDiskModel{},
+			HasCustomArgs:     types.BoolValue(false),
+			Hookscript:        types.StringNull(),
+			Hotplug:           []types.String{},
+			HotplugCPU:        types.BoolValue(slices.Contains(hotplug, "cpu")),
+			HotplugDisk:       types.BoolValue(slices.Contains(hotplug, "disk")),
+			HotplugMemory:     types.BoolValue(slices.Contains(hotplug, "memory")),
+			HotplugNetwork:    types.BoolValue(slices.Contains(hotplug, "network")),
+			HotplugUSB:        types.BoolValue(slices.Contains(hotplug, "usb")),
+			Hugepages:         configString(rawConfig, "hugepages"),
+			IPConfigs:         []vmConfigDataSourceIPConfigModel{},
+			KeepHugepages:     configBool(rawConfig, "keephugepages", types.BoolNull()),
+			KVM:               configBool(rawConfig, "kvm", types.BoolNull()),
+			LocalTime:         configBool(rawConfig, "localtime", types.BoolNull()),
 			Name:              types.StringValue(vm.Name),
 			NetworkInterfaces: []vmConfigDataSourceNetworkInterfaceModel{},
+			NUMANodes:         []vmConfigDataSourceNUMANodeModel{},
+			PrimaryMAC:        types.StringNull(),
 			Node:              types.StringValue(vm.Node),
+			Reboot:            configBool(rawConfig, "reboot", types.BoolNull()),
+			RequiresReboot:    types.BoolValue(requiresReboot),
 			Status:            types.StringValue(vm.Status),
+			Tablet:            configBool(rawConfig, "tablet", types.BoolNull()),
+			TotalDiskBytes:    types.Int64Value(0),
 			VMID:              config.Filter.VMID,
 		},
 		Filter: config.Filter,
+		Found:  types.BoolValue(true),
+	}
+	for _, category := range hotplug {
+		state.Data.Hotplug = append(state.Data.Hotplug, types.StringValue(category))
+	}
+	for _, device := range bootOrder {
+		state.Data.BootOrder = append(state.Data.BootOrder, types.StringValue(device))
 	}
 	if vm.VirtualMachineConfig != nil {
-		for name, config := range vm.VirtualMachineConfig.MergeNets() {
+		if affinity := vm.VirtualMachineConfig.Affinity; affinity != "" {
+			state.Data.Affinity = types.StringValue(affinity)
+			cpus, err := parseCPUSet(affinity)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The value for the 'affinity' property was not expected: %s", err.Error()),
+				)
+			}
+			for _, cpu := range cpus {
+				state.Data.AffinityCPUs = append(state.Data.AffinityCPUs, types.Int64Value(cpu))
+			}
+		}
+		if vm.VirtualMachineConfig.Args != "" {
+			// the arguments are passed through unparsed since they are free-form and may contain commas and
+			// equal signs
+			state.Data.Args = types.StringValue(vm.VirtualMachineConfig.Args)
+			state.Data.HasCustomArgs = types.BoolValue(strings.TrimSpace(vm.VirtualMachineConfig.Args) != "")
+		}
+		if vm.VirtualMachineConfig.Hookscript != "" {
+			state.Data.Hookscript = types.StringValue(vm.VirtualMachineConfig.Hookscript)
+		}
+		var bridges map[string]nodeBridge
+		if config.Filter.ResolveBridges.ValueBool() {
+			bridges, err = d.providerData.nodeBridges(ctx, nodeName)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Proxmox VE API: Failed to Retrieve Node Network",
+					fmt.Sprintf("Failed to retrieve the bridges of the cluster node '%s':\n\t%s", nodeName,
+						err.Error()),
+				)
+				return
+			}
+		}
+		assumeFirewall := config.Filter.AssumeFirewallDefault.ValueBool()
+		// the interfaces are taken from the raw configuration rather than MergeNets so that keys which only
+		// differ in how their index is written (eg: net1 and net01) are both seen and reported below; keys which
+		// only start with 'net' are skipped by the loop
+		nets := map[string]string{}
+		for key := range rawConfig {
+			if strings.HasPrefix(key, "net") {
+				nets[key] = configString(rawConfig, key).ValueString()
+			}
+		}
+		netIndexes := map[int]string{}
+		for _, name := range sortedConfigKeys(nets) {
+			config := nets[name]
+			prefix, index := splitConfigKey(name)
+			if prefix != "net" || index < 0 {
+				tflog.Debug(ctx, "skipping unexpected network interface key", map[string]any{
+					"name":  name,
+					"vm_id": vmID,
+				})
+				continue
+			}
 			tflog.Info(ctx, "parsing network interface", map[string]any{"name": name, "config": config, "vm_id": vmID})
 			if config == "" {
 				continue
 			}
-			state.Data.NetworkInterfaces = append(state.Data.NetworkInterfaces,
-				d.parseNetworkConfig(ctx, config, resp.Diagnostics))
+			if other, ok := netIndexes[index]; ok {
+				resp.Diagnostics.AddWarning(
+					"Duplicate Network Interface Index",
+					fmt.Sprintf("The network interfaces '%s' and '%s' of the virtual machine with the ID '%d' "+
+						"both have the index %d; the configuration may be corrupted.", other, name, vmID, index),
+				)
+			} else {
+				netIndexes[index] = name
+			}
+			iface := d.parseNetworkConfig(ctx, config, &resp.Diagnostics)
+			iface.FirewallConfigured = types.BoolValue(!iface.Firewall.IsNull())
+			if iface.Firewall.IsNull() && assumeFirewall {
+				iface.Firewall = types.BoolValue(true)
+			}
+			if bridge, ok := bridges[iface.Bridge.ValueString()]; ok {
+				iface.BridgeVLANAware = types.BoolValue(bridge.vlanAware())
+			}
+			if state.Data.PrimaryMAC.IsNull() {
+				state.Data.PrimaryMAC = iface.HardwareAddress
+			}
+			if nicFields != nil {
+				iface.selectFields(nicFields)
+			}
+			state.Data.NetworkInterfaces = append(state.Data.NetworkInterfaces, iface)
+		}
+		ipConfigs := vm.VirtualMachineConfig.MergeIPConfigs()
+		for _, name := range sortedConfigKeys(ipConfigs) {
+			if ipConfigs[name] == "" {
+				continue
+			}
+			state.Data.IPConfigs = append(state.Data.IPConfigs,
+				d.parseIPConfig(ctx, name, ipConfigs[name], &resp.Diagnostics))
+		}
+		// MergeNumas in go-proxmox stores the merged entries in the wrong field and always returns nil, so the
+		// NUMA nodes are taken from the raw configuration instead
+		numas := map[string]string{}
+		for key := range rawConfig {
+			if prefix, index := splitConfigKey(key); prefix == "numa" && index >= 0 {
+				numas[key] = configString(rawConfig, key).ValueString()
+			}
+		}
+		for _, name := range sortedConfigKeys(numas) {
+			if numas[name] == "" {
+				continue
+			}
+			state.Data.NUMANodes = append(state.Data.NUMANodes,
+				d.parseNUMAConfig(ctx, name, numas[name], &resp.Diagnostics))
+		}
+		var filesystems []agentFilesystem
+		if vm.Status == "running" && agentEnabled(rawConfig) {
+			filesystems, err = d.providerData.agentFSInfo(ctx, nodeName, vmID)
+			if err != nil {
+				tflog.Warn(ctx, "failed to retrieve guest filesystems", map[string]any{
+					"vm_id": vmID,
+					"error": err.Error(),
+				})
+			}
+		}
+		disks := vm.VirtualMachineConfig.MergeDisks()
+		for _, name := range sortedConfigKeys(disks) {
+			if disks[name] == "" {
+				continue
+			}
+			properties := parsePropertyString(disks[name], "file")
+			if properties["media"] == "cdrom" {
+				state.Data.CDROMDrives = append(state.Data.CDROMDrives, vmConfigDataSourceCDROMDriveModel{
+					Interface: types.StringValue(name),
+					ISO:       propertyString(properties, "file"),
+					RawConfig: types.StringValue(disks[name]),
+				})
+				continue
+			}
+			disk := d.parseDiskConfig(ctx, name, disks[name], &resp.Diagnostics)
+			disk.IsBoot = types.BoolValue(slices.Contains(bootOrder, name))
+			if used, total, ok := agentDiskUsage(filesystems, properties["serial"]); ok {
+				disk.UsedBytes = types.Int64Value(used)
+				disk.FreeBytes = types.Int64Value(max(total-used, 0))
+			}
+			state.Data.Disks = append(state.Data.Disks, disk)
+			state.Data.TotalDiskBytes = types.Int64Value(
+				state.Data.TotalDiskBytes.ValueInt64() + disk.SizeBytes.ValueInt64())
+		}
+		for _, device := range bootOrder {
+			if slices.ContainsFunc(state.Data.Disks, func(disk vmConfigDataSourceDiskModel) bool {
+				return disk.Interface.ValueString() == device
+			}) {
+				state.Data.BootDisk = types.StringValue(device)
+				break
+			}
 		}
 	} else {
 		tflog.Warn(ctx, "VM config is nil", map[string]any{"vm_id": vmID})
@@ -268,17 +971,68 @@ func (d *vmConfigDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 }
 
+// selectFields sets every attribute of the network interface which is not in the given set of fields to null.
+func (m *vmConfigDataSourceNetworkInterfaceModel) selectFields(fields map[string]bool) {
+	if !fields["bridge"] {
+		m.Bridge = types.StringNull()
+	}
+	if !fields["bridge_vlan_aware"] {
+		m.BridgeVLANAware = types.BoolNull()
+	}
+	if !fields["firewall"] {
+		m.Firewall = types.BoolNull()
+	}
+	if !fields["firewall_configured"] {
+		m.FirewallConfigured = types.BoolNull()
+	}
+	if !fields["is_locally_administered"] {
+		m.IsLocallyAdministered = types.BoolNull()
+	}
+	if !fields["link_down"] {
+		m.LinkDown = types.BoolNull()
+	}
+	if !fields["mac_addr"] {
+		m.HardwareAddress = types.StringNull()
+	}
+	if !fields["model"] {
+		m.Model = types.StringNull()
+	}
+	if !fields["mtu"] {
+		m.MTU = types.Int32Null()
+	}
+	if !fields["mtu_inherit"] {
+		m.MTUInherit = types.BoolNull()
+	}
+	if !fields["queues"] {
+		m.Queues = types.Int32Null()
+	}
+	if !fields["rate"] {
+		m.Rate = types.Int32Null()
+	}
+	if !fields["raw_config"] {
+		m.RawConfig = types.StringNull()
+	}
+	if !fields["tag"] {
+		m.Tag = types.Int32Null()
+	}
+	if !fields["trunks"] {
+		m.Trunks = nil
+	}
+}
+
 func (d *vmConfigDataSource) parseNetworkConfig(_ context.Context, config string,
-	diag diag.Diagnostics) vmConfigDataSourceNetworkInterfaceModel {
+	diag *diag.Diagnostics) vmConfigDataSourceNetworkInterfaceModel {
 
 	iface := vmConfigDataSourceNetworkInterfaceModel{
-		RawConfig: types.StringValue(config),
+		MTUInherit: types.BoolValue(false),
+		RawConfig:  types.StringValue(config),
 	}
 	pairs := strings.Split(config, ",")
 	for _, pair := range pairs {
-		kv := strings.Split(pair, "=")
-		key := kv[0]
-		value := kv[1]
+		key, value, found := cutProperty(pair)
+		if !found {
+			continue
+		}
 
 		switch key {
 		case "model":
@@ -311,6 +1065,15 @@ func (d *vmConfigDataSource) parseNetworkConfig(_ context.Context, config string
 			iface.LinkDown = types.BoolValue(val)
 		case "macaddr", "virtio":
 			iface.HardwareAddress = types.StringValue(value)
+			mac, err := parseMACAddress(value)
+			if err != nil {
+				diag.AddWarning(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The MAC address of the network interface is not valid: %s", err.Error()),
+				)
+				continue
+			}
+			iface.IsLocallyAdministered = types.BoolValue(isLocallyAdministered(mac))
 		case "mtu":
 			val, err := strconv.ParseInt(value, 10, 32)
 			if err != nil {
@@ -323,6 +1086,7 @@ func (d *vmConfigDataSource) parseNetworkConfig(_ context.Context, config string
 				continue
 			}
 			iface.MTU = types.Int32Value(int32(val))
+			iface.MTUInherit = types.BoolValue(val == nicMTUInherit)
 		case "queues":
 			val, err := strconv.ParseInt(value, 10, 32)
 			if err != nil {
@@ -358,11 +1122,20 @@ func (d *vmConfigDataSource) parseNetworkConfig(_ context.Context, config string
 				)
 				continue
 			}
+			if !isValidVLANTag(val) {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The value for the 'tag' property for the network interface must be between %d "+
+						"and %d: %d", minVLANTag, maxVLANTag, val),
+				)
+				continue
+			}
 			iface.Tag = types.Int32Value(int32(val))
 		case "trunks":
-			iface.Trunks = []types.Int32{}
+			// sort and de-duplicate the trunks so that reordering them does not cause a diff
+			trunks := []int64{}
 			for _, trunk := range strings.Split(value, ";") {
-				val, err := strconv.ParseInt(trunk, 10, 32)
+				val, err := strconv.ParseInt(strings.TrimSpace(trunk), 10, 32)
 				if err != nil {
 					diag.AddError(
 						"Unexpected VM Config Value",
@@ -372,9 +1145,261 @@ func (d *vmConfigDataSource) parseNetworkConfig(_ context.Context, config string
 					)
 					continue
 				}
-				iface.Trunks = append(iface.Trunks, types.Int32Value(int32(val)))
+				if !isValidVLANTag(val) {
+					diag.AddError(
+						"Unexpected VM Config Value",
+						fmt.Sprintf("The values for the 'trunks' property for the network interface must be "+
+							"between %d and %d: %d", minVLANTag, maxVLANTag, val),
+					)
+					continue
+				}
+				trunks = append(trunks, val)
+			}
+			slices.Sort(trunks)
+			iface.Trunks = []types.Int32{}
+			for _, trunk := range slices.Compact(trunks) {
+				iface.Trunks = append(iface.Trunks, types.Int32Value(int32(trunk)))
 			}
 		}
 	}
 	return iface
 }
+
+// parseIPConfig parses a cloud-init ipconfigN value such as 'ip=10.0.0.5/24,gw=10.0.0.1,ip6=auto'. Addresses
+// may be 'dhcp' (IPv4 and IPv6) or 'auto' (IPv6 only) in addition to a static CIDR. Any address or gateway that
+// is not present in the configuration is returned as null.
+func (d *vmConfigDataSource) parseIPConfig(_ context.Context, name, config string,
+	diag *diag.Diagnostics) vmConfigDataSourceIPConfigModel {
+
+	ipConfig := vmConfigDataSourceIPConfigModel{
+		IPv4Address: types.StringNull(),
+		IPv4Gateway: types.StringNull(),
+		IPv6Address: types.StringNull(),
+		IPv6Gateway: types.StringNull(),
+		Name:        types.StringValue(name),
+		RawConfig:   types.StringValue(config),
+	}
+	for _, pair := range strings.Split(config, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, found := cutProperty(pair)
+		if !found || value == "" {
+			diag.AddError(
+				"Unexpected VM Config Value",
+				fmt.Sprintf("The '%s' entry '%s' is not a valid key=value pair", name, pair),
+			)
+			continue
+		}
+
+		switch key {
+		case "ip":
+			if value != "dhcp" && !isCIDR(value, false) {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The 'ip' property for '%s' must be 'dhcp' or an IPv4 CIDR: %s", name, value),
+				)
+				continue
+			}
+			ipConfig.IPv4Address = types.StringValue(value)
+		case "gw":
+			if !isIP(value, false) {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The 'gw' property for '%s' must be an IPv4 address: %s", name, value),
+				)
+				continue
+			}
+			ipConfig.IPv4Gateway = types.StringValue(value)
+		case "ip6":
+			if value != "dhcp" && value != "auto" && !isCIDR(value, true) {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The 'ip6' property for '%s' must be 'dhcp', 'auto' or an IPv6 CIDR: %s",
+						name, value),
+				)
+				continue
+			}
+			ipConfig.IPv6Address = types.StringValue(value)
+		case "gw6":
+			if !isIP(value, true) {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The 'gw6' property for '%s' must be an IPv6 address: %s", name, value),
+				)
+				continue
+			}
+			ipConfig.IPv6Gateway = types.StringValue(value)
+		}
+	}
+	return ipConfig
+}
+
+// isIP returns whether or not the given value is an IPv4 address or, when ipv6 is true, an IPv6 address.
+func isIP(value string, ipv6 bool) bool {
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return false
+	}
+	return addr.Is6() == ipv6 && !addr.Is4In6()
+}
+
+// isCIDR returns whether or not the given value is an IPv4 address or, when ipv6 is true, an IPv6 address in
+// CIDR notation.
+func isCIDR(value string, ipv6 bool) bool {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return false
+	}
+	return prefix.Addr().Is6() == ipv6 && !prefix.Addr().Is4In6()
+}
+
+// parseDiskConfig parses a disk configuration value such as 'local-lvm:vm-100-disk-0,cache=writeback,size=32G'.
+// The first entry is the volume ID, which may also be given as 'file=<volume>'.
+func (d *vmConfigDataSource) parseDiskConfig(_ context.Context, name, config string,
+	diag *diag.Diagnostics) vmConfigDataSourceDiskModel {
+
+	disk := vmConfigDataSourceDiskModel{
+		Cache:     types.StringNull(),
+		Format:    types.StringNull(),
+		FreeBytes: types.Int64Null(),
+		Interface: types.StringValue(name),
+		RawConfig: types.StringValue(config),
+		SizeBytes: types.Int64Null(),
+		Storage:   types.StringNull(),
+		UsedBytes: types.Int64Null(),
+		Volume:    types.StringNull(),
+	}
+	for i, pair := range strings.Split(config, ",") {
+		key, value, found := cutProperty(pair)
+		if !found {
+			if i == 0 {
+				key, value = "file", key
+			} else {
+				continue
+			}
+		}
+
+		switch key {
+		case "file":
+			disk.Volume = types.StringValue(value)
+			if storage, _, found := strings.Cut(value, ":"); found {
+				disk.Storage = types.StringValue(storage)
+			}
+		case "cache":
+			disk.Cache = types.StringValue(value)
+		case "format":
+			disk.Format = types.StringValue(value)
+		case "size":
+			size, err := parseSize(value)
+			if err != nil {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The value for the 'size' property for the disk '%s' was not expected: %s",
+						name, err.Error()),
+				)
+				continue
+			}
+			disk.SizeBytes = types.Int64Value(size)
+		}
+	}
+	return disk
+}
+
+// parseBootOrder returns the devices in the boot option (eg: 'order=scsi0;ide2;net0') in order. The legacy
+// format (eg: 'cdn') only identifies disks by the separate bootdisk option, so for it the boot disk is the only
+// device returned, and only if booting from disk ('c') is enabled. An unset boot option uses the legacy default.
+func parseBootOrder(boot, bootDisk string) []string {
+	devices := []string{}
+	properties := parsePropertyString(boot, "legacy")
+	if order, ok := properties["order"]; ok {
+		for _, device := range strings.Split(order, ";") {
+			if device = strings.TrimSpace(device); device != "" && !slices.Contains(devices, device) {
+				devices = append(devices, device)
+			}
+		}
+		return devices
+	}
+	legacy, ok := properties["legacy"]
+	if !ok {
+		legacy = defaultLegacyBoot
+	}
+	if bootDisk = strings.TrimSpace(bootDisk); bootDisk != "" && strings.Contains(legacy, "c") {
+		devices = append(devices, bootDisk)
+	}
+	return devices
+}
+
+// parseHotplug parses the hotplug option into a sorted list of hot-pluggable device categories. The option is
+// either a comma-separated list of categories, 0 to disable hot-plugging or 1 (or unset) for the defaults.
+func parseHotplug(value string) []string {
+	switch value = strings.TrimSpace(value); value {
+	case "", "1":
+		return slices.Clone(defaultHotplug)
+	case "0":
+		return []string{}
+	}
+	categories := []string{}
+	for _, category := range strings.Split(value, ",") {
+		category = strings.ToLower(strings.TrimSpace(category))
+		if category != "" && !slices.Contains(categories, category) {
+			categories = append(categories, category)
+		}
+	}
+	slices.Sort(categories)
+	return categories
+}
+
+// parseNUMAConfig parses a numaN configuration value such as 'cpus=0-1;4,hostnodes=0,memory=1024,policy=bind'.
+func (d *vmConfigDataSource) parseNUMAConfig(_ context.Context, name, config string,
+	diag *diag.Diagnostics) vmConfigDataSourceNUMANodeModel {
+
+	numa := vmConfigDataSourceNUMANodeModel{
+		Memory:    types.Int64Null(),
+		Name:      types.StringValue(name),
+		Policy:    types.StringNull(),
+		RawConfig: types.StringValue(config),
+	}
+	for _, pair := range strings.Split(config, ",") {
+		key, value, found := cutProperty(pair)
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "cpus", "hostnodes":
+			ids, err := parseCPUSet(value)
+			if err != nil {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The value for the '%s' property for '%s' was not expected: %s",
+						key, name, err.Error()),
+				)
+				continue
+			}
+			list := []types.Int64{}
+			for _, id := range ids {
+				list = append(list, types.Int64Value(id))
+			}
+			if key == "cpus" {
+				numa.CPUs = list
+			} else {
+				numa.HostNodes = list
+			}
+		case "memory":
+			val, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				diag.AddError(
+					"Unexpected VM Config Value",
+					fmt.Sprintf("The value for the 'memory' property for '%s' was not expected: %s",
+						name, err.Error()),
+				)
+				continue
+			}
+			numa.Memory = types.Int64Value(val)
+		case "policy":
+			numa.Policy = types.StringValue(value)
+		}
+	}
+	return numa
+}
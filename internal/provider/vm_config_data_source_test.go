@@ -0,0 +1,761 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVMConfigParseNetworkConfigPadded(t *testing.T) {
+	var diags diag.Diagnostics
+	config := " virtio = BC:24:11:AA:BB:CC , bridge = vmbr0 ,firewall= 1, tag =100 , trunks= 20 ; 10 "
+	iface := (&vmConfigDataSource{}).parseNetworkConfig(context.Background(), config, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := iface.Bridge; got != types.StringValue("vmbr0") {
+		t.Errorf("bridge = %v, want vmbr0", got)
+	}
+	if got := iface.HardwareAddress; got != types.StringValue("BC:24:11:AA:BB:CC") {
+		t.Errorf("mac_addr = %v, want BC:24:11:AA:BB:CC", got)
+	}
+	if got := iface.Firewall; got != types.BoolValue(true) {
+		t.Errorf("firewall = %v, want true", got)
+	}
+	if got := iface.Tag; got != types.Int32Value(100) {
+		t.Errorf("tag = %v, want 100", got)
+	}
+	if want := []types.Int32{types.Int32Value(10), types.Int32Value(20)}; !reflect.DeepEqual(iface.Trunks, want) {
+		t.Errorf("trunks = %v, want %v", iface.Trunks, want)
+	}
+	if got := iface.RawConfig; got != types.StringValue(config) {
+		t.Errorf("raw_config = %v, want the unmodified value", got)
+	}
+}
+
+func TestVMConfigParseDiskConfigPadded(t *testing.T) {
+	var diags diag.Diagnostics
+	config := " local-lvm:vm-100-disk-0 , cache = writeback ,size= 32G "
+	disk := (&vmConfigDataSource{}).parseDiskConfig(context.Background(), "scsi0", config, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := disk.Volume; got != types.StringValue("local-lvm:vm-100-disk-0") {
+		t.Errorf("volume = %v, want local-lvm:vm-100-disk-0", got)
+	}
+	if got := disk.Storage; got != types.StringValue("local-lvm") {
+		t.Errorf("storage = %v, want local-lvm", got)
+	}
+	if got := disk.Cache; got != types.StringValue("writeback") {
+		t.Errorf("cache = %v, want writeback", got)
+	}
+	if got := disk.SizeBytes; got != types.Int64Value(32<<30) {
+		t.Errorf("size_bytes = %v, want %d", got, int64(32<<30))
+	}
+}
+
+func TestVMConfigParseNUMAConfigPadded(t *testing.T) {
+	var diags diag.Diagnostics
+	numa := (&vmConfigDataSource{}).parseNUMAConfig(context.Background(), "numa0",
+		" cpus = 0-1 , memory = 1024 , policy = bind ", &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []types.Int64{types.Int64Value(0), types.Int64Value(1)}; !reflect.DeepEqual(numa.CPUs, want) {
+		t.Errorf("cpus = %v, want %v", numa.CPUs, want)
+	}
+	if got := numa.Memory; got != types.Int64Value(1024) {
+		t.Errorf("memory = %v, want 1024", got)
+	}
+	if got := numa.Policy; got != types.StringValue("bind") {
+		t.Errorf("policy = %v, want bind", got)
+	}
+}
+
+func TestVMConfigParseIPConfigPadded(t *testing.T) {
+	var diags diag.Diagnostics
+	ipConfig := (&vmConfigDataSource{}).parseIPConfig(context.Background(), "ipconfig0",
+		" ip = 10.0.0.5/24 , gw = 10.0.0.1 ", &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := ipConfig.IPv4Address; got != types.StringValue("10.0.0.5/24") {
+		t.Errorf("ipv4_address = %v, want 10.0.0.5/24", got)
+	}
+	if got := ipConfig.IPv4Gateway; got != types.StringValue("10.0.0.1") {
+		t.Errorf("ipv4_gateway = %v, want 10.0.0.1", got)
+	}
+}
+
+func TestVMConfigParseIPConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    vmConfigDataSourceIPConfigModel
+		wantErr bool
+	}{
+		{
+			name:   "dhcp and auto",
+			config: "ip=dhcp,ip6=auto",
+			want: vmConfigDataSourceIPConfigModel{
+				IPv4Address: types.StringValue("dhcp"),
+				IPv4Gateway: types.StringNull(),
+				IPv6Address: types.StringValue("auto"),
+				IPv6Gateway: types.StringNull(),
+			},
+		},
+		{
+			name:   "static dual stack",
+			config: "ip=10.0.0.5/24,gw=10.0.0.1,ip6=2001:db8::5/64,gw6=2001:db8::1",
+			want: vmConfigDataSourceIPConfigModel{
+				IPv4Address: types.StringValue("10.0.0.5/24"),
+				IPv4Gateway: types.StringValue("10.0.0.1"),
+				IPv6Address: types.StringValue("2001:db8::5/64"),
+				IPv6Gateway: types.StringValue("2001:db8::1"),
+			},
+		},
+		{
+			name:   "IPv6 only",
+			config: "ip6=2001:db8::5/64,gw6=2001:db8::1",
+			want: vmConfigDataSourceIPConfigModel{
+				IPv4Address: types.StringNull(),
+				IPv4Gateway: types.StringNull(),
+				IPv6Address: types.StringValue("2001:db8::5/64"),
+				IPv6Gateway: types.StringValue("2001:db8::1"),
+			},
+		},
+		{
+			name:   "IPv6 dhcp without gateways",
+			config: "ip=10.0.0.5/24,ip6=dhcp",
+			want: vmConfigDataSourceIPConfigModel{
+				IPv4Address: types.StringValue("10.0.0.5/24"),
+				IPv4Gateway: types.StringNull(),
+				IPv6Address: types.StringValue("dhcp"),
+				IPv6Gateway: types.StringNull(),
+			},
+		},
+		{name: "auto is IPv6 only", config: "ip=auto", wantErr: true},
+		{name: "IPv6 gateway for IPv4", config: "ip=10.0.0.5/24,gw=2001:db8::1", wantErr: true},
+		{name: "address without prefix", config: "ip6=2001:db8::5", wantErr: true},
+		{name: "missing value", config: "ip=", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := (&vmConfigDataSource{}).parseIPConfig(context.Background(), "ipconfig0", test.config, &diags)
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("parseIPConfig(%q) diagnostics = %v, want errors %t", test.config, diags, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			test.want.Name = types.StringValue("ipconfig0")
+			test.want.RawConfig = types.StringValue(test.config)
+			if got != test.want {
+				t.Errorf("parseIPConfig(%q) = %+v, want %+v", test.config, got, test.want)
+			}
+		})
+	}
+}
+
+// readTestVMConfig reads the configuration of the VM 100 on the node pve1 from a test server returning the given
+// configuration and bridges, applying the given filter options.
+func readTestVMConfig(t *testing.T, vmConfig map[string]any, bridges []map[string]any,
+	filter vmConfigDataSourceFilterModel) (*vmConfigDataSourceDataModel, diag.Diagnostics) {
+
+	t.Helper()
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api2/json/nodes/pve1/status":
+			writeTestData(t, w, map[string]any{})
+		case "/api2/json/nodes/pve1/qemu/100/status/current":
+			writeTestData(t, w, map[string]any{"vmid": 100, "name": "test", "status": "stopped"})
+		case "/api2/json/nodes/pve1/qemu/100/config":
+			writeTestData(t, w, vmConfig)
+		case "/api2/json/nodes/pve1/qemu/100/pending":
+			writeTestData(t, w, []any{})
+		case "/api2/json/nodes/pve1/network":
+			writeTestData(t, w, bridges)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+	filter.NodeName = types.StringValue("pve1")
+	filter.VMID = types.Int32Value(100)
+	var state vmConfigDataSourceModel
+	diags := readTestDataSource(t, &vmConfigDataSource{providerData: data},
+		vmConfigDataSourceModel{Filter: &filter}, &state)
+	return state.Data, diags
+}
+
+func TestVMConfigTotalDiskBytes(t *testing.T) {
+	data, diags := readTestVMConfig(t, map[string]any{
+		"scsi0":     "local-lvm:vm-100-disk-1,size=32G",
+		"scsi1":     "local-lvm:vm-100-disk-2,size=512M",
+		"virtio0":   "ceph:vm-100-disk-3,size=2T",
+		"ide2":      "local:iso/debian.iso,media=cdrom,size=600M",
+		"efidisk0":  "local-lvm:vm-100-disk-0,efitype=4m,size=4M",
+		"tpmstate0": "local-lvm:vm-100-disk-4,version=v2.0,size=4M",
+	}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := len(data.Disks); got != 3 {
+		t.Errorf("found %d disks, want 3", got)
+	}
+	if want := types.Int64Value(32<<30 + 512<<20 + 2<<40); data.TotalDiskBytes != want {
+		t.Errorf("total_disk_bytes = %v, want %v", data.TotalDiskBytes, want)
+	}
+}
+
+func TestVMConfigAffinityAndNUMA(t *testing.T) {
+	data, diags := readTestVMConfig(t, map[string]any{
+		"affinity": "0-3",
+		"numa":     1,
+		"numa0":    "cpus=0-1,memory=1024",
+	}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := data.Affinity; got != types.StringValue("0-3") {
+		t.Errorf("affinity = %v, want 0-3", got)
+	}
+	wantCPUs := []types.Int64{types.Int64Value(0), types.Int64Value(1), types.Int64Value(2), types.Int64Value(3)}
+	if !reflect.DeepEqual(data.AffinityCPUs, wantCPUs) {
+		t.Errorf("affinity_cpus = %v, want %v", data.AffinityCPUs, wantCPUs)
+	}
+	if len(data.NUMANodes) != 1 {
+		t.Fatalf("found %d NUMA nodes, want 1", len(data.NUMANodes))
+	}
+	numa := data.NUMANodes[0]
+	if got := numa.Name; got != types.StringValue("numa0") {
+		t.Errorf("name = %v, want numa0", got)
+	}
+	if want := []types.Int64{types.Int64Value(0), types.Int64Value(1)}; !reflect.DeepEqual(numa.CPUs, want) {
+		t.Errorf("cpus = %v, want %v", numa.CPUs, want)
+	}
+	if got := numa.Memory; got != types.Int64Value(1024) {
+		t.Errorf("memory = %v, want 1024", got)
+	}
+}
+
+func TestVMConfigFlags(t *testing.T) {
+	// explicitly set flags are returned while unset ones are null so that they can be told apart from defaults
+	data, diags := readTestVMConfig(t, map[string]any{"tablet": 0, "kvm": 1}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := data.Tablet; got != types.BoolValue(false) {
+		t.Errorf("tablet = %v, want false", got)
+	}
+	if got := data.KVM; got != types.BoolValue(true) {
+		t.Errorf("kvm = %v, want true", got)
+	}
+	for name, got := range map[string]types.Bool{
+		"acpi":      data.ACPI,
+		"localtime": data.LocalTime,
+		"reboot":    data.Reboot,
+	} {
+		if !got.IsNull() {
+			t.Errorf("%s = %v, want null", name, got)
+		}
+	}
+}
+
+func TestVMConfigParseNetworkConfigTag(t *testing.T) {
+	tests := []struct {
+		config  string
+		want    types.Int32
+		wantErr bool
+	}{
+		{config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,tag=100", want: types.Int32Value(100)},
+		{config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0", want: types.Int32Null()},
+		{config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,tag=0", wantErr: true},
+		{config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,tag=4095", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.config, func(t *testing.T) {
+			var diags diag.Diagnostics
+			iface := (&vmConfigDataSource{}).parseNetworkConfig(context.Background(), test.config, &diags)
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("parseNetworkConfig(%q) diagnostics = %v, want errors %t", test.config, diags, test.wantErr)
+			}
+			if !test.wantErr && iface.Tag != test.want {
+				t.Errorf("tag = %v, want %v", iface.Tag, test.want)
+			}
+		})
+	}
+}
+
+func TestVMConfigLargeDisk(t *testing.T) {
+	// 4 TiB in bytes does not fit into an int32, so it must not be truncated on the way to the state
+	data, diags := readTestVMConfig(t, map[string]any{
+		"scsi0": "ceph:vm-100-disk-0,size=4T",
+	}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.Int64Value(4 << 40)
+	if len(data.Disks) != 1 || data.Disks[0].SizeBytes != want {
+		t.Fatalf("disks = %+v, want a single disk of %v bytes", data.Disks, want)
+	}
+	if data.TotalDiskBytes != want {
+		t.Errorf("total_disk_bytes = %v, want %v", data.TotalDiskBytes, want)
+	}
+}
+
+func TestVMConfigParseNetworkConfigTrunks(t *testing.T) {
+	tests := []struct {
+		name    string
+		trunks  string
+		want    []int32
+		wantErr bool
+	}{
+		{name: "sorted", trunks: "10;20;30", want: []int32{10, 20, 30}},
+		{name: "unsorted", trunks: "30;10;20", want: []int32{10, 20, 30}},
+		{name: "duplicated", trunks: "20;10;20;10", want: []int32{10, 20}},
+		{name: "bounds", trunks: "4094;1", want: []int32{1, 4094}},
+		{name: "zero", trunks: "10;0", wantErr: true},
+		{name: "too large", trunks: "10;4095", wantErr: true},
+		{name: "not a number", trunks: "10;abc", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			config := "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,trunks=" + test.trunks
+			iface := (&vmConfigDataSource{}).parseNetworkConfig(context.Background(), config, &diags)
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("parseNetworkConfig(%q) diagnostics = %v, want errors %t", config, diags, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			var want []types.Int32
+			for _, trunk := range test.want {
+				want = append(want, types.Int32Value(trunk))
+			}
+			if !reflect.DeepEqual(iface.Trunks, want) {
+				t.Errorf("trunks = %v, want %v", iface.Trunks, want)
+			}
+		})
+	}
+}
+
+func TestVMConfigDuplicateNetworkInterfaceIndex(t *testing.T) {
+	data, diags := readTestVMConfig(t, map[string]any{
+		"net1":  "virtio=BC:24:11:AA:BB:01,bridge=vmbr0",
+		"net01": "virtio=BC:24:11:AA:BB:02,bridge=vmbr1",
+	}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Duplicate Network Interface Index" {
+		t.Errorf("diagnostics = %v, want a single duplicate network interface index warning", diags)
+	}
+	if got := len(data.NetworkInterfaces); got != 2 {
+		t.Errorf("found %d network interfaces, want 2", got)
+	}
+}
+
+func TestVMConfigResolveBridges(t *testing.T) {
+	vmConfig := map[string]any{
+		"net0": "virtio=BC:24:11:AA:BB:01,bridge=vmbr0,tag=100",
+		"net1": "virtio=BC:24:11:AA:BB:02,bridge=vmbr1",
+		"net2": "virtio=BC:24:11:AA:BB:03,bridge=vmbr9",
+	}
+	bridges := []map[string]any{
+		{"iface": "vmbr0", "type": "bridge", "bridge_vlan_aware": 1},
+		{"iface": "vmbr1", "type": "bridge"},
+	}
+	tests := []struct {
+		name    string
+		resolve types.Bool
+		want    []types.Bool
+	}{
+		{
+			name:    "resolved",
+			resolve: types.BoolValue(true),
+			want:    []types.Bool{types.BoolValue(true), types.BoolValue(false), types.BoolNull()},
+		},
+		{
+			name:    "not resolved",
+			resolve: types.BoolNull(),
+			want:    []types.Bool{types.BoolNull(), types.BoolNull(), types.BoolNull()},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, diags := readTestVMConfig(t, vmConfig, bridges,
+				vmConfigDataSourceFilterModel{ResolveBridges: test.resolve})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			var got []types.Bool
+			for _, iface := range data.NetworkInterfaces {
+				got = append(got, iface.BridgeVLANAware)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("bridge_vlan_aware = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestVMConfigCDROMDrives(t *testing.T) {
+	data, diags := readTestVMConfig(t, map[string]any{
+		"ide0":  "local:iso/debian-12.iso,media=cdrom,size=628M",
+		"ide2":  "none,media=cdrom",
+		"scsi0": "local-lvm:vm-100-disk-0,size=32G",
+	}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := []vmConfigDataSourceCDROMDriveModel{
+		{
+			Interface: types.StringValue("ide0"),
+			ISO:       types.StringValue("local:iso/debian-12.iso"),
+			RawConfig: types.StringValue("local:iso/debian-12.iso,media=cdrom,size=628M"),
+		},
+		{
+			Interface: types.StringValue("ide2"),
+			ISO:       types.StringValue("none"),
+			RawConfig: types.StringValue("none,media=cdrom"),
+		},
+	}
+	if !reflect.DeepEqual(data.CDROMDrives, want) {
+		t.Errorf("cdrom_drives = %+v, want %+v", data.CDROMDrives, want)
+	}
+	if len(data.Disks) != 1 || data.Disks[0].Interface != types.StringValue("scsi0") {
+		t.Errorf("disks = %+v, want only scsi0", data.Disks)
+	}
+}
+
+func TestVMConfigParseNetworkConfigMTU(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		wantMTU     types.Int32
+		wantInherit types.Bool
+		wantErr     bool
+	}{
+		{
+			name:        "inherit",
+			config:      "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,mtu=1",
+			wantMTU:     types.Int32Value(1),
+			wantInherit: types.BoolValue(true),
+		},
+		{
+			name:        "jumbo frames",
+			config:      "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,mtu=9000",
+			wantMTU:     types.Int32Value(9000),
+			wantInherit: types.BoolValue(false),
+		},
+		{
+			name:        "unset",
+			config:      "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0",
+			wantMTU:     types.Int32Null(),
+			wantInherit: types.BoolValue(false),
+		},
+		{name: "invalid", config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,mtu=jumbo", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			iface := (&vmConfigDataSource{}).parseNetworkConfig(context.Background(), test.config, &diags)
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("parseNetworkConfig(%q) diagnostics = %v, want errors %t", test.config, diags, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			if iface.MTU != test.wantMTU || iface.MTUInherit != test.wantInherit {
+				t.Errorf("mtu = %v, mtu_inherit = %v, want %v and %v", iface.MTU, iface.MTUInherit, test.wantMTU,
+					test.wantInherit)
+			}
+		})
+	}
+}
+
+func TestParseHotplug(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "network,usb", want: []string{"network", "usb"}},
+		{value: "USB, network,usb", want: []string{"network", "usb"}},
+		{value: "cpu,memory,disk", want: []string{"cpu", "disk", "memory"}},
+		{value: "0", want: []string{}},
+		{value: "1", want: []string{"disk", "network", "usb"}},
+		{value: "", want: []string{"disk", "network", "usb"}},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if got := parseHotplug(test.value); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseHotplug(%q) = %v, want %v", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestVMConfigHotplugFlags(t *testing.T) {
+	tests := []struct {
+		hotplug string
+		want    map[string]bool
+	}{
+		{
+			hotplug: "network,usb",
+			want:    map[string]bool{"cpu": false, "disk": false, "memory": false, "network": true, "usb": true},
+		},
+		{
+			hotplug: "0",
+			want:    map[string]bool{"cpu": false, "disk": false, "memory": false, "network": false, "usb": false},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.hotplug, func(t *testing.T) {
+			data, diags := readTestVMConfig(t, map[string]any{"hotplug": test.hotplug}, nil,
+				vmConfigDataSourceFilterModel{})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			got := map[string]bool{
+				"cpu":     data.HotplugCPU.ValueBool(),
+				"disk":    data.HotplugDisk.ValueBool(),
+				"memory":  data.HotplugMemory.ValueBool(),
+				"network": data.HotplugNetwork.ValueBool(),
+				"usb":     data.HotplugUSB.ValueBool(),
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("hotplug flags = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseBootOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		boot     string
+		bootDisk string
+		want     []string
+	}{
+		{name: "order", boot: "order=scsi1;scsi0;net0", want: []string{"scsi1", "scsi0", "net0"}},
+		{name: "padded order", boot: " order = ide2; scsi0 ;scsi0", want: []string{"ide2", "scsi0"}},
+		{name: "legacy with disk", boot: "cdn", bootDisk: "scsi0", want: []string{"scsi0"}},
+		{name: "legacy without disk", boot: "nd", bootDisk: "scsi0", want: []string{}},
+		{name: "default legacy", bootDisk: "virtio0", want: []string{"virtio0"}},
+		{name: "nothing", want: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseBootOrder(test.boot, test.bootDisk); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseBootOrder(%q, %q) = %v, want %v", test.boot, test.bootDisk, got, test.want)
+			}
+		})
+	}
+}
+
+func TestVMConfigBootDisk(t *testing.T) {
+	data, diags := readTestVMConfig(t, map[string]any{
+		"boot":  "order=ide2;scsi1;scsi0;net0",
+		"ide2":  "local:iso/debian-12.iso,media=cdrom",
+		"scsi0": "local-lvm:vm-100-disk-0,size=32G",
+		"scsi1": "local-lvm:vm-100-disk-1,size=8G",
+		"scsi2": "local-lvm:vm-100-disk-2,size=8G",
+	}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := data.BootDisk; got != types.StringValue("scsi1") {
+		t.Errorf("boot_disk = %v, want scsi1", got)
+	}
+	want := map[string]bool{"scsi0": true, "scsi1": true, "scsi2": false}
+	for _, disk := range data.Disks {
+		if got := disk.IsBoot.ValueBool(); got != want[disk.Interface.ValueString()] {
+			t.Errorf("is_boot of %s = %t, want %t", disk.Interface.ValueString(), got, !got)
+		}
+	}
+}
+
+func TestVMConfigStrayNetworkInterfaceKeys(t *testing.T) {
+	data, diags := readTestVMConfig(t, map[string]any{
+		"net0":    "virtio=BC:24:11:AA:BB:01,bridge=vmbr0",
+		"net":     "virtio=BC:24:11:AA:BB:02,bridge=vmbr1",
+		"netdev0": "type=tap,id=net0",
+	}, nil, vmConfigDataSourceFilterModel{})
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(data.NetworkInterfaces) != 1 {
+		t.Fatalf("found %d network interfaces, want 1", len(data.NetworkInterfaces))
+	}
+	if got := data.NetworkInterfaces[0].HardwareAddress; got != types.StringValue("BC:24:11:AA:BB:01") {
+		t.Errorf("mac_addr = %v, want the address of net0", got)
+	}
+}
+
+func TestVMConfigAssumeFirewallDefault(t *testing.T) {
+	vmConfig := map[string]any{
+		"net0": "virtio=BC:24:11:AA:BB:01,bridge=vmbr0",
+		"net1": "virtio=BC:24:11:AA:BB:02,bridge=vmbr0,firewall=0",
+		"net2": "virtio=BC:24:11:AA:BB:03,bridge=vmbr0,firewall=1",
+	}
+	tests := []struct {
+		name           string
+		assume         types.Bool
+		wantFirewall   []types.Bool
+		wantConfigured []types.Bool
+	}{
+		{
+			name:           "default",
+			assume:         types.BoolNull(),
+			wantFirewall:   []types.Bool{types.BoolNull(), types.BoolValue(false), types.BoolValue(true)},
+			wantConfigured: []types.Bool{types.BoolValue(false), types.BoolValue(true), types.BoolValue(true)},
+		},
+		{
+			name:           "assumed",
+			assume:         types.BoolValue(true),
+			wantFirewall:   []types.Bool{types.BoolValue(true), types.BoolValue(false), types.BoolValue(true)},
+			wantConfigured: []types.Bool{types.BoolValue(false), types.BoolValue(true), types.BoolValue(true)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, diags := readTestVMConfig(t, vmConfig, nil,
+				vmConfigDataSourceFilterModel{AssumeFirewallDefault: test.assume})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			var firewall, configured []types.Bool
+			for _, iface := range data.NetworkInterfaces {
+				firewall = append(firewall, iface.Firewall)
+				configured = append(configured, iface.FirewallConfigured)
+			}
+			if !reflect.DeepEqual(firewall, test.wantFirewall) {
+				t.Errorf("firewall = %v, want %v", firewall, test.wantFirewall)
+			}
+			if !reflect.DeepEqual(configured, test.wantConfigured) {
+				t.Errorf("firewall_configured = %v, want %v", configured, test.wantConfigured)
+			}
+		})
+	}
+}
+
+func TestVMConfigParseNetworkConfigMAC(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		want        types.Bool
+		wantWarning bool
+	}{
+		{name: "globally unique", config: "virtio=00:1A:2B:3C:4D:5E,bridge=vmbr0", want: types.BoolValue(false)},
+		{name: "locally administered", config: "virtio=52:54:00:12:34:56,bridge=vmbr0", want: types.BoolValue(true)},
+		{name: "invalid", config: "virtio=BC:24:11:AA:BB,bridge=vmbr0", want: types.BoolNull(), wantWarning: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			iface := (&vmConfigDataSource{}).parseNetworkConfig(context.Background(), test.config, &diags)
+			if diags.HasError() || (diags.WarningsCount() > 0) != test.wantWarning {
+				t.Fatalf("parseNetworkConfig(%q) diagnostics = %v, want warnings %t", test.config, diags,
+					test.wantWarning)
+			}
+			if iface.IsLocallyAdministered != test.want {
+				t.Errorf("is_locally_administered = %v, want %v", iface.IsLocallyAdministered, test.want)
+			}
+		})
+	}
+}
+
+func TestVMConfigAgentDiskUsage(t *testing.T) {
+	// every disk on a virtio-scsi-single controller sits at unit 0 of its own controller, so the SCSI disks without
+	// a serial number cannot be told apart and must not be matched
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api2/json/nodes/pve1/status":
+			writeTestData(t, w, map[string]any{})
+		case "/api2/json/nodes/pve1/qemu/100/status/current":
+			writeTestData(t, w, map[string]any{"vmid": 100, "name": "test", "status": "running"})
+		case "/api2/json/nodes/pve1/qemu/100/config":
+			writeTestData(t, w, map[string]any{
+				"agent":   "1",
+				"scsihw":  "virtio-scsi-single",
+				"scsi0":   "local-lvm:vm-100-disk-0,iothread=1,size=32G",
+				"scsi1":   "local-lvm:vm-100-disk-1,iothread=1,size=64G",
+				"virtio0": "local-lvm:vm-100-disk-2,serial=data-disk,size=8G",
+			})
+		case "/api2/json/nodes/pve1/qemu/100/pending":
+			writeTestData(t, w, []any{})
+		case "/api2/json/nodes/pve1/qemu/100/agent/get-fsinfo":
+			scsiDisk := []map[string]any{{"bus-type": "scsi", "bus": 0, "target": 0, "unit": 0}}
+			writeTestData(t, w, map[string]any{"result": []map[string]any{
+				{"name": "sda1", "used-bytes": 100, "total-bytes": 1000, "disk": scsiDisk},
+				{"name": "sdb1", "used-bytes": 200, "total-bytes": 2000, "disk": scsiDisk},
+				{"name": "vda1", "used-bytes": 30, "total-bytes": 300, "disk": []map[string]any{
+					{"bus-type": "virtio", "serial": "data-disk"},
+				}},
+			}})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+	filter := vmConfigDataSourceFilterModel{NodeName: types.StringValue("pve1"), VMID: types.Int32Value(100)}
+	var state vmConfigDataSourceModel
+	diags := readTestDataSource(t, &vmConfigDataSource{providerData: data}, vmConfigDataSourceModel{Filter: &filter},
+		&state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := map[string][2]types.Int64{
+		"scsi0":   {types.Int64Null(), types.Int64Null()},
+		"scsi1":   {types.Int64Null(), types.Int64Null()},
+		"virtio0": {types.Int64Value(30), types.Int64Value(270)},
+	}
+	if len(state.Data.Disks) != len(want) {
+		t.Fatalf("found %d disks, want %d", len(state.Data.Disks), len(want))
+	}
+	for _, disk := range state.Data.Disks {
+		name := disk.Interface.ValueString()
+		if got := [2]types.Int64{disk.UsedBytes, disk.FreeBytes}; got != want[name] {
+			t.Errorf("%s used_bytes and free_bytes = %v, want %v", name, got, want[name])
+		}
+	}
+}
+
+func TestVMConfigDescription(t *testing.T) {
+	// the API returns the description already decoded from the percent-encoded form in the configuration file
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   types.String
+	}{
+		{
+			name:   "multi-line",
+			config: map[string]any{"description": "# Web server\n\nOwner: ops, tier=1\n50% of capacity\n"},
+			want:   types.StringValue("# Web server\n\nOwner: ops, tier=1\n50% of capacity\n"),
+		},
+		{
+			name:   "literal escape sequence",
+			config: map[string]any{"description": "encoded as %0A in the file"},
+			want:   types.StringValue("encoded as %0A in the file"),
+		},
+		{name: "unset", config: map[string]any{}, want: types.StringNull()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, diags := readTestVMConfig(t, test.config, nil, vmConfigDataSourceFilterModel{})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if data.Description != test.want {
+				t.Errorf("description = %v, want %v", data.Description, test.want)
+			}
+		})
+	}
+}
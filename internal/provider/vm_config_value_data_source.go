@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// vmConfigValueDefaultKeys maps the prefix of a VM configuration key to the name of the property whose key may
+// be omitted from the first element of its property string (eg: the volume in 'local-lvm:vm-100-disk-0,size=32G').
+var vmConfigValueDefaultKeys = map[string]string{
+	"agent":    "enabled",
+	"audio":    "device",
+	"boot":     "legacy",
+	"efidisk":  "file",
+	"hostpci":  "host",
+	"ide":      "file",
+	"rng":      "source",
+	"sata":     "file",
+	"scsi":     "file",
+	"startup":  "order",
+	"tpmstate": "file",
+	"unused":   "file",
+	"usb":      "host",
+	"vga":      "type",
+	"virtio":   "file",
+	"watchdog": "model",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmConfigValueDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmConfigValueDataSource{}
+)
+
+func NewVMConfigValueDataSource() datasource.DataSource {
+	return &vmConfigValueDataSource{}
+}
+
+type vmConfigValueDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmConfigValueDataSourceModel struct {
+	Data   *vmConfigValueDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmConfigValueDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmConfigValueDataSourceFilterModel struct {
+	Key      types.String `tfsdk:"key"`
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type vmConfigValueDataSourceDataModel struct {
+	Attributes map[string]types.String `tfsdk:"attributes"`
+	Raw        types.String            `tfsdk:"raw"`
+}
+
+func (d *vmConfigValueDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmConfigValueDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_config_value"
+}
+
+func (d *vmConfigValueDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a single option of a VM's configuration, both raw and parsed into its properties, " +
+			"for options which are not covered by the proxmoxve_vm_config data source.",
+		MarkdownDescription: "Retrieves a single option of a VM's configuration, both raw and parsed into its " +
+			"properties, for options which are not covered by the `proxmoxve_vm_config` data source.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"attributes": schema.MapAttribute{
+						Description: "Properties of the option parsed from its key=value pairs; the model of a " +
+							"network interface is reported as model and macaddr and the volume of a disk as file. " +
+							"Empty when the option is not set",
+						MarkdownDescription: "Properties of the option parsed from its `key=value` pairs; the model " +
+							"of a network interface is reported as `model` and `macaddr` and the volume of a disk " +
+							"as `file`. Empty when the option is not set",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"raw": schema.StringAttribute{
+						Description:         "Value of the option exactly as configured; null when it is not set",
+						MarkdownDescription: "Value of the option exactly as configured; null when it is not set",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description:         "Name of the configuration option (eg: net1, scsi0 or smbios1)",
+						MarkdownDescription: "Name of the configuration option (eg: `net1`, `scsi0` or `smbios1`)",
+						Required:            true,
+					},
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmConfigValueDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmConfigValueDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID, node and key are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the VM configuration value.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM "+
+				"configuration value or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the VM configuration value.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+	key := strings.TrimSpace(config.Filter.Key.ValueString())
+	if key == "" {
+		resp.Diagnostics.AddError(
+			"Filter Key Is Required", "You must specify the configuration key to retrieve.",
+		)
+		return
+	}
+
+	// query for the configuration
+	rawConfig, err := d.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve VM config", map[string]any{"vm_id": vmID, "error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := vmConfigValueDataSourceModel{
+		Data: &vmConfigValueDataSourceDataModel{
+			Attributes: map[string]types.String{},
+			Raw:        configString(rawConfig, key),
+		},
+		Filter: config.Filter,
+	}
+	if !state.Data.Raw.IsNull() {
+		for name, value := range parseConfigValue(key, state.Data.Raw.ValueString()) {
+			state.Data.Attributes[name] = types.StringValue(value)
+		}
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// parseConfigValue parses the raw value of the given VM configuration key into its properties. Network
+// interfaces are normalized so that the model and MAC address get their own properties; other values use the
+// default property name of their key's prefix for an unnamed first element.
+func parseConfigValue(key, value string) map[string]string {
+	prefix, _ := splitConfigKey(key)
+	if prefix == "net" {
+		return normalizeNetConfig(value)
+	}
+	return parsePropertyString(value, vmConfigValueDefaultKeys[prefix])
+}
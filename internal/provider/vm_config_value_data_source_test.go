@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConfigValue(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+		want  map[string]string
+	}{
+		{
+			key:   "scsi0",
+			value: "local-lvm:vm-100-disk-0,cache=writeback,discard=on,size=32G",
+			want: map[string]string{
+				"file":    "local-lvm:vm-100-disk-0",
+				"cache":   "writeback",
+				"discard": "on",
+				"size":    "32G",
+			},
+		},
+		{
+			key:   "net1",
+			value: "virtio=bc:24:11:aa:bb:cc,bridge=vmbr0,firewall=1,tag=100",
+			want: map[string]string{
+				"model":    "virtio",
+				"macaddr":  "BC:24:11:AA:BB:CC",
+				"bridge":   "vmbr0",
+				"firewall": "1",
+				"tag":      "100",
+			},
+		},
+		{
+			key:   "smbios1",
+			value: "uuid=8c5e1a3e-2f4b-4d1c-9a7e-1b2c3d4e5f60",
+			want:  map[string]string{"uuid": "8c5e1a3e-2f4b-4d1c-9a7e-1b2c3d4e5f60"},
+		},
+		{
+			key:   "agent",
+			value: "1,fstrim_cloned_disks=1",
+			want:  map[string]string{"enabled": "1", "fstrim_cloned_disks": "1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			if got := parseConfigValue(test.key, test.value); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseConfigValue(%q, %q) = %v, want %v", test.key, test.value, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultVGAType is the display type a VM uses when its vga option is not set.
+	defaultVGAType = "std"
+
+	minVGAMemory = 4
+	maxVGAMemory = 512
+)
+
+// vgaTypes are the display types accepted by the vga option besides the serialN terminals.
+var vgaTypes = []string{
+	"cirrus", "none", "qxl", "qxl2", "qxl3", "qxl4", "std", "virtio", "virtio-gl", "vmware",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vmDisplayResource{}
+	_ resource.ResourceWithConfigure      = &vmDisplayResource{}
+	_ resource.ResourceWithImportState    = &vmDisplayResource{}
+	_ resource.ResourceWithValidateConfig = &vmDisplayResource{}
+)
+
+func NewVMDisplayResource() resource.Resource {
+	return &vmDisplayResource{}
+}
+
+type vmDisplayResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmDisplayResourceModel struct {
+	Memory   types.Int64  `tfsdk:"memory"`
+	NodeName types.String `tfsdk:"node_name"`
+	Type     types.String `tfsdk:"type"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+func (r *vmDisplayResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *vmDisplayResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_display"
+}
+
+func (r *vmDisplayResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the display (vga option) of a VM without managing the rest of the VM. Other " +
+			"properties of the option, such as clipboard, are preserved. Destroying the resource removes the " +
+			"option so the default std display is used. Existing displays are imported using an ID in the " +
+			"format node_name/vm_id (eg: pve1/100).",
+		MarkdownDescription: "Manages the display (`vga` option) of a VM without managing the rest of the VM. " +
+			"Other properties of the option, such as `clipboard`, are preserved. Destroying the resource " +
+			"removes the option so the default `std` display is used. Existing displays are imported using an " +
+			"ID in the format `node_name/vm_id` (eg: `pve1/100`).",
+		Attributes: map[string]schema.Attribute{
+			"memory": schema.Int64Attribute{
+				Description: fmt.Sprintf("Display memory in MiB (%d-%d); the display type's default is used when "+
+					"omitted", minVGAMemory, maxVGAMemory),
+				MarkdownDescription: fmt.Sprintf("Display memory in MiB (%d-%d); the display type's default is "+
+					"used when omitted", minVGAMemory, maxVGAMemory),
+				Optional: true,
+			},
+			"node_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Display type (%s or serial0-serial3)", strings.Join(vgaTypes, ", ")),
+				MarkdownDescription: fmt.Sprintf("Display type (`%s` or `serial0`-`serial3`)",
+					strings.Join(vgaTypes, "`, `")),
+				Required: true,
+			},
+			"vm_id": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vmDisplayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config vmDisplayResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsNull() && !config.Type.IsUnknown() && !isValidVGAType(config.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Display Type",
+			fmt.Sprintf("The display type '%s' is not valid; it must be one of %s or serial0-serial3.",
+				config.Type.ValueString(), strings.Join(vgaTypes, ", ")),
+		)
+	}
+	if !config.Memory.IsNull() && !config.Memory.IsUnknown() &&
+		(config.Memory.ValueInt64() < minVGAMemory || config.Memory.ValueInt64() > maxVGAMemory) {
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("memory"),
+			"Invalid Display Memory",
+			fmt.Sprintf("The display memory must be between %d and %d MiB: %d", minVGAMemory, maxVGAMemory,
+				config.Memory.ValueInt64()),
+		)
+	}
+}
+
+func (r *vmDisplayResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmDisplayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the display
+	r.setDisplay(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmDisplayResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmDisplayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the configuration
+	nodeName := state.NodeName.ValueString()
+	vmID := int(state.VMID.ValueInt32())
+	rawConfig, err := r.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if isNotFoundError(err) {
+		tflog.Warn(ctx, "VM no longer exists", map[string]any{"node_name": nodeName, "vm_id": vmID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+	properties := parsePropertyString(configString(rawConfig, "vga").ValueString(), "type")
+	state.Type = types.StringValue(defaultVGAType)
+	if properties["type"] != "" {
+		state.Type = types.StringValue(properties["type"])
+	}
+	state.Memory = propertyInt64(properties, "memory")
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmDisplayResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmDisplayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the display
+	r.setDisplay(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmDisplayResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmDisplayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remove the display so the default is used
+	nodeName := state.NodeName.ValueString()
+	vmID := int(state.VMID.ValueInt32())
+	tflog.Info(ctx, "removing VM display", map[string]any{"vm_id": vmID})
+	err := r.providerData.client.Put(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/config", url.PathEscape(nodeName), vmID),
+		map[string]any{"delete": "vga"}, nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update VM Config",
+			fmt.Sprintf("Failed to remove the display of the virtual machine with the ID '%d':\n\t%s", vmID,
+				err.Error()),
+		)
+		return
+	}
+}
+
+func (r *vmDisplayResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	// the display is filled in by the subsequent read
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' is not in the format node_name/vm_id.", req.ID),
+		)
+		return
+	}
+	vmID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil || vmID <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' does not contain a valid VM ID.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), int32(vmID))...)
+}
+
+// setDisplay reads the current vga option of the VM, replaces its type and memory and writes it back. The
+// digest of the configuration which was read is passed along so that the update fails rather than overwriting a
+// concurrent change to the VM.
+func (r *vmDisplayResource) setDisplay(ctx context.Context, plan vmDisplayResourceModel,
+	diags *diag.Diagnostics) {
+
+	nodeName := plan.NodeName.ValueString()
+	vmID := int(plan.VMID.ValueInt32())
+	rawConfig, err := r.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		diags.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+
+	memory := ""
+	if !plan.Memory.IsNull() {
+		memory = strconv.FormatInt(plan.Memory.ValueInt64(), 10)
+	}
+	params := map[string]any{
+		"vga": setVGAOptions(configString(rawConfig, "vga").ValueString(), plan.Type.ValueString(), memory),
+	}
+	if digest := configString(rawConfig, "digest"); !digest.IsNull() {
+		params["digest"] = digest.ValueString()
+	}
+	tflog.Info(ctx, "setting VM display", map[string]any{"vm_id": vmID, "vga": params["vga"]})
+	err = r.providerData.client.Put(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/config",
+		url.PathEscape(nodeName), vmID), params, nil)
+	if err != nil {
+		diags.AddError(
+			"Proxmox VE API: Failed to Update VM Config",
+			fmt.Sprintf("Failed to set the display of the virtual machine with the ID '%d':\n\t%s", vmID,
+				err.Error()),
+		)
+	}
+}
+
+// isValidVGAType returns whether or not the given value is a display type accepted by the vga option.
+func isValidVGAType(value string) bool {
+	if slices.Contains(vgaTypes, value) {
+		return true
+	}
+	index, found := strings.CutPrefix(value, "serial")
+	return found && len(index) == 1 && index[0] >= '0' && index[0] <= '3'
+}
+
+// setVGAOptions returns the given vga option with its type and memory replaced, removing the memory when it is
+// empty. The remaining properties are kept verbatim and in their original order.
+func setVGAOptions(config, vgaType, memory string) string {
+	options := []string{"type=" + vgaType}
+	for i, option := range strings.Split(config, ",") {
+		key, _, found := strings.Cut(option, "=")
+		key = strings.TrimSpace(key)
+		if strings.TrimSpace(option) == "" || (i == 0 && !found) || key == "type" || key == "memory" {
+			continue
+		}
+		options = append(options, option)
+	}
+	if memory != "" {
+		options = append(options, "memory="+memory)
+	}
+	return strings.Join(options, ",")
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetVGAOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		vgaType string
+		memory  string
+		want    string
+	}{
+		{name: "unset", vgaType: "std", want: "type=std"},
+		{name: "switch type", config: "std,memory=16", vgaType: "qxl", memory: "16", want: "type=qxl,memory=16"},
+		{name: "adjust memory", config: "type=virtio,memory=16", vgaType: "virtio", memory: "64",
+			want: "type=virtio,memory=64"},
+		{name: "remove memory", config: "qxl,memory=32", vgaType: "qxl", want: "type=qxl"},
+		{name: "other properties kept", config: "type=std,clipboard=vnc,memory=16", vgaType: "virtio-gl",
+			memory: "128", want: "type=virtio-gl,clipboard=vnc,memory=128"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := setVGAOptions(test.config, test.vgaType, test.memory); got != test.want {
+				t.Errorf("setVGAOptions(%q, %q, %q) = %q, want %q", test.config, test.vgaType, test.memory, got,
+					test.want)
+			}
+		})
+	}
+}
+
+func TestIsValidVGAType(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "std", want: true},
+		{value: "virtio-gl", want: true},
+		{value: "serial0", want: true},
+		{value: "serial3", want: true},
+		{value: "serial4", want: false},
+		{value: "serial", want: false},
+		{value: "VGA", want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if got := isValidVGAType(test.value); got != test.want {
+				t.Errorf("isValidVGAType(%q) = %t, want %t", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestVMDisplayUpdate(t *testing.T) {
+	var got map[string]any
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api2/json/nodes/pve1/qemu/100/config" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeTestData(t, w, map[string]any{"vga": "std,clipboard=vnc,memory=16", "digest": "abc123"})
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Errorf("failed to decode the request: %v", err)
+			}
+			writeTestData(t, w, nil)
+		}
+	})
+	model := func(vgaType string, memory int64) vmDisplayResourceModel {
+		return vmDisplayResourceModel{
+			Memory:   types.Int64Value(memory),
+			NodeName: types.StringValue("pve1"),
+			Type:     types.StringValue(vgaType),
+			VMID:     types.Int32Value(100),
+		}
+	}
+	diags := updateTestResource(t, &vmDisplayResource{providerData: data}, model("std", 16), model("qxl", 64))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := map[string]any{"vga": "type=qxl,clipboard=vnc,memory=64", "digest": "abc123"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("config update = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmLocationDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmLocationDataSource{}
+)
+
+func NewVMLocationDataSource() datasource.DataSource {
+	return &vmLocationDataSource{}
+}
+
+type vmLocationDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmLocationDataSourceModel struct {
+	Data   *vmLocationDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmLocationDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmLocationDataSourceFilterModel struct {
+	VMID types.Int32 `tfsdk:"vm_id"`
+}
+
+type vmLocationDataSourceDataModel struct {
+	Node   types.String `tfsdk:"node"`
+	Status types.String `tfsdk:"status"`
+	Type   types.String `tfsdk:"type"`
+}
+
+func (d *vmLocationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmLocationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_location"
+}
+
+func (d *vmLocationDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"node": schema.StringAttribute{
+						Computed: true,
+					},
+					"status": schema.StringAttribute{
+						Computed: true,
+					},
+					"type": schema.StringAttribute{
+						Description:         "Guest type, either 'qemu' or 'lxc'",
+						MarkdownDescription: "Guest type, either `qemu` or `lxc`",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmLocationDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmLocationDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID is specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to locate the VM.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to locate the VM.",
+		)
+		return
+	}
+	vmID := uint64(config.Filter.VMID.ValueInt32())
+
+	// search the cluster resources for the VM
+	resources, err := d.providerData.clusterVMResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the list of cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+	state := vmLocationDataSourceModel{
+		Filter: config.Filter,
+	}
+	for _, resource := range resources {
+		if resource.VMID != vmID {
+			continue
+		}
+		tflog.Info(ctx, "located VM", map[string]any{"vm_id": vmID, "node": resource.Node, "type": resource.Type})
+		state.Data = &vmLocationDataSourceDataModel{
+			Node:   types.StringValue(resource.Node),
+			Status: types.StringValue(resource.Status),
+			Type:   types.StringValue(resource.Type),
+		}
+		break
+	}
+	if state.Data == nil {
+		resp.Diagnostics.AddError(
+			"VM Not Found",
+			fmt.Sprintf("No virtual machine or container with the ID '%d' exists in the cluster.", vmID),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vmMigrationResource{}
+	_ resource.ResourceWithConfigure      = &vmMigrationResource{}
+	_ resource.ResourceWithImportState    = &vmMigrationResource{}
+	_ resource.ResourceWithValidateConfig = &vmMigrationResource{}
+)
+
+func NewVMMigrationResource() resource.Resource {
+	return &vmMigrationResource{}
+}
+
+type vmMigrationResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmMigrationResourceModel struct {
+	BWLimit           types.Int64  `tfsdk:"bwlimit"`
+	MigrationDuration types.String `tfsdk:"migration_duration"`
+	Online            types.Bool   `tfsdk:"online"`
+	SourceNode        types.String `tfsdk:"source_node"`
+	TargetNode        types.String `tfsdk:"target_node"`
+	VMID              types.Int32  `tfsdk:"vm_id"`
+}
+
+func (r *vmMigrationResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *vmMigrationResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_migration"
+}
+
+func (r *vmMigrationResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Migrates a VM to a target node and keeps it there. Destroying the resource leaves the VM " +
+			"where it is. Existing VMs are imported using their VM ID, which adopts the node they currently run on " +
+			"as the target node.",
+		MarkdownDescription: "Migrates a VM to a target node and keeps it there. Destroying the resource leaves " +
+			"the VM where it is. Existing VMs are imported using their `vm_id`, which adopts the node they " +
+			"currently run on as the `target_node`.",
+		Attributes: map[string]schema.Attribute{
+			"bwlimit": bwlimitSchemaAttribute("migration"),
+			"migration_duration": schema.StringAttribute{
+				Description:         "How long the last migration took (eg: 1m32s)",
+				MarkdownDescription: "How long the last migration took (eg: `1m32s`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"online": schema.BoolAttribute{
+				Description:         "Use online/live migration if the VM is running",
+				MarkdownDescription: "Use online/live migration if the VM is running",
+				Optional:            true,
+			},
+			"source_node": schema.StringAttribute{
+				Description:         "Node the VM was migrated from",
+				MarkdownDescription: "Node the VM was migrated from",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_node": schema.StringAttribute{
+				Description:         "Node the VM should run on; changing it migrates the VM again",
+				MarkdownDescription: "Node the VM should run on; changing it migrates the VM again",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vmMigrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config vmMigrationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateBWLimit(config.BWLimit, path.Root("bwlimit"), &resp.Diagnostics)
+}
+
+func (r *vmMigrationResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmMigrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// locate the VM and migrate it if it is not already on the target node
+	vmID := int(plan.VMID.ValueInt32())
+	targetNode := plan.TargetNode.ValueString()
+	sourceNode, err := r.providerData.qemuVMNode(ctx, vmID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+	if sourceNode == "" {
+		resp.Diagnostics.AddError(
+			"VM Not Found",
+			fmt.Sprintf("No virtual machine with the ID '%d' exists in the cluster.", vmID),
+		)
+		return
+	}
+	plan.SourceNode = types.StringValue(sourceNode)
+	plan.MigrationDuration = types.StringValue(time.Duration(0).String())
+	if sourceNode != targetNode {
+		duration := r.migrate(ctx, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.MigrationDuration = types.StringValue(duration.String())
+	} else {
+		tflog.Info(ctx, "VM is already on the target node", map[string]any{
+			"vm_id":       vmID,
+			"target_node": targetNode,
+		})
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmMigrationResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmMigrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// find the node currently hosting the VM; reporting it as the target node causes a new migration to be
+	// planned if the VM was moved elsewhere
+	vmID := int(state.VMID.ValueInt32())
+	node, err := r.providerData.qemuVMNode(ctx, vmID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+	if node == "" {
+		tflog.Warn(ctx, "VM no longer exists", map[string]any{"vm_id": vmID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.TargetNode = types.StringValue(node)
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmMigrationResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan; the remaining attributes only affect the next migration so there is nothing to update
+	var plan vmMigrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmMigrationResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	// the VM is left on its current node
+}
+
+func (r *vmMigrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	// the target node is filled in by the subsequent read
+	vmID, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil || vmID <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' is not a valid VM ID.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), int32(vmID))...)
+}
+
+// migrate migrates the VM to the target node, logging the progress reported by the migration task, and returns
+// how long the migration took. If the context is cancelled the migration task is stopped.
+func (r *vmMigrationResource) migrate(ctx context.Context, plan vmMigrationResourceModel,
+	diags *diag.Diagnostics) time.Duration {
+
+	vmID := int(plan.VMID.ValueInt32())
+	vm := r.providerData.virtualMachine(ctx, plan.SourceNode.ValueString(), vmID, diags)
+	if diags.HasError() {
+		return 0
+	}
+
+	options := &proxmox.VirtualMachineMigrateOptions{
+		Target: plan.TargetNode.ValueString(),
+		Online: proxmox.IntOrBool(plan.Online.ValueBool()),
+	}
+	if !plan.BWLimit.IsNull() {
+		options.BWLimit = uint64(plan.BWLimit.ValueInt64())
+	}
+	start := time.Now()
+	task, err := vm.Migrate(ctx, options)
+	if err != nil {
+		diags.AddError(
+			"Proxmox VE API: Failed to Migrate VM",
+			fmt.Sprintf("Failed to start the migration of the virtual machine with the ID '%d' to the node "+
+				"'%s':\n\t%s", vmID, options.Target, err.Error()),
+		)
+		return 0
+	}
+	tflog.Info(ctx, "started VM migration", map[string]any{
+		"vm_id":       vmID,
+		"source_node": plan.SourceNode.ValueString(),
+		"target_node": options.Target,
+		"upid":        task.UPID,
+	})
+
+	err = r.providerData.waitForTaskWithLog(ctx, task, func(line string) {
+		tflog.Info(ctx, "migration progress", map[string]any{"vm_id": vmID, "log": line})
+	})
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// use a new context since the original one has already been cancelled
+		if stopErr := task.Stop(context.WithoutCancel(ctx)); stopErr != nil {
+			tflog.Warn(ctx, "failed to stop migration task", map[string]any{
+				"upid":  task.UPID,
+				"error": stopErr.Error(),
+			})
+		}
+	}
+	if err != nil {
+		diags.AddError(
+			"Proxmox VE API: Failed to Migrate VM",
+			fmt.Sprintf("Failed to migrate the virtual machine with the ID '%d' to the node '%s':\n\t%s", vmID,
+				options.Target, err.Error()),
+		)
+		return 0
+	}
+	duration := time.Since(start).Round(time.Second)
+	tflog.Info(ctx, "finished VM migration", map[string]any{
+		"vm_id":    vmID,
+		"duration": duration.String(),
+	})
+	return duration
+}
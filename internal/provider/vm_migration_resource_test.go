@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVMMigrationImport(t *testing.T) {
+	tests := []struct {
+		id       string
+		want     *vmMigrationResourceModel
+		wantErr  string
+		wantNone bool
+	}{
+		{
+			id:   "100",
+			want: &vmMigrationResourceModel{TargetNode: types.StringValue("pve2"), VMID: types.Int32Value(100)},
+		},
+		{id: "300", wantNone: true},
+		{id: "pve1/100", wantErr: "is not a valid VM ID"},
+		{id: "0", wantErr: "is not a valid VM ID"},
+	}
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api2/json/cluster/resources" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+					return
+				}
+				// a container sharing the VM ID must not be mistaken for the VM
+				writeTestData(t, w, []map[string]any{
+					{"id": "lxc/100", "type": "lxc", "vmid": 100, "node": "pve1"},
+					{"id": "qemu/100", "type": "qemu", "vmid": 100, "node": "pve2"},
+					{"id": "qemu/200", "type": "qemu", "vmid": 200, "node": "pve1"},
+				})
+			})
+			state, diags := importTestResource(t, &vmMigrationResource{providerData: data}, test.id)
+			if test.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), test.wantErr) {
+					t.Fatalf("diagnostics = %v, want an error containing %q", diags, test.wantErr)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if test.wantNone {
+				if !state.Raw.IsNull() {
+					t.Errorf("state = %v, want the resource to be removed", state.Raw)
+				}
+				return
+			}
+			var got vmMigrationResourceModel
+			if diags := state.Get(context.Background(), &got); diags.HasError() {
+				t.Fatalf("failed to read the state: %v", diags)
+			}
+			if got.VMID != test.want.VMID || got.TargetNode != test.want.TargetNode {
+				t.Errorf("vm_id = %v, target_node = %v, want %v and %v", got.VMID, got.TargetNode, test.want.VMID,
+					test.want.TargetNode)
+			}
+		})
+	}
+}
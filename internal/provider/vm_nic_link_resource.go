@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vmNICLinkResource{}
+	_ resource.ResourceWithConfigure      = &vmNICLinkResource{}
+	_ resource.ResourceWithImportState    = &vmNICLinkResource{}
+	_ resource.ResourceWithValidateConfig = &vmNICLinkResource{}
+)
+
+func NewVMNICLinkResource() resource.Resource {
+	return &vmNICLinkResource{}
+}
+
+type vmNICLinkResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmNICLinkResourceModel struct {
+	Interface types.String `tfsdk:"interface"`
+	LinkDown  types.Bool   `tfsdk:"link_down"`
+	NodeName  types.String `tfsdk:"node_name"`
+	VMID      types.Int32  `tfsdk:"vm_id"`
+}
+
+func (r *vmNICLinkResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *vmNICLinkResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_nic_link"
+}
+
+func (r *vmNICLinkResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Sets the link state of a single network interface of a VM, eg: to isolate the VM from the " +
+			"network, without managing the rest of the VM. All other options of the interface are preserved. " +
+			"Destroying the resource leaves the link in its current state. Existing interfaces are imported " +
+			"using an ID in the format node_name/vm_id/interface (eg: pve1/100/net0).",
+		MarkdownDescription: "Sets the link state of a single network interface of a VM, eg: to isolate the VM " +
+			"from the network, without managing the rest of the VM. All other options of the interface are " +
+			"preserved. Destroying the resource leaves the link in its current state. Existing interfaces are " +
+			"imported using an ID in the format `node_name/vm_id/interface` (eg: `pve1/100/net0`).",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description:         "Name of the network interface (eg: net0)",
+				MarkdownDescription: "Name of the network interface (eg: `net0`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"link_down": schema.BoolAttribute{
+				Description:         "Whether or not the link of the network interface is disconnected",
+				MarkdownDescription: "Whether or not the link of the network interface is disconnected",
+				Required:            true,
+			},
+			"node_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vmNICLinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config vmNICLinkResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Interface.IsUnknown() {
+		return
+	}
+	if prefix, index := splitConfigKey(config.Interface.ValueString()); prefix != "net" || index < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interface"),
+			"Invalid Network Interface",
+			fmt.Sprintf("The network interface '%s' is not valid; it must be in the format netN (eg: net0).",
+				config.Interface.ValueString()),
+		)
+	}
+}
+
+func (r *vmNICLinkResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmNICLinkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the link state
+	r.setLinkDown(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmNICLinkResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmNICLinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the network interface
+	nodeName := state.NodeName.ValueString()
+	vmID := int(state.VMID.ValueInt32())
+	name := state.Interface.ValueString()
+	rawConfig, err := r.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if isNotFoundError(err) {
+		tflog.Warn(ctx, "VM no longer exists", map[string]any{"node_name": nodeName, "vm_id": vmID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+	value := configString(rawConfig, name)
+	if value.IsNull() {
+		tflog.Warn(ctx, "network interface no longer exists", map[string]any{"vm_id": vmID, "interface": name})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.LinkDown = types.BoolValue(parsePropertyString(value.ValueString(), "")["link_down"] == "1")
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmNICLinkResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmNICLinkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the link state
+	r.setLinkDown(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmNICLinkResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	// the link is left in its current state
+}
+
+func (r *vmNICLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	// the link state is filled in by the subsequent read
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' is not in the format node_name/vm_id/interface.", req.ID),
+		)
+		return
+	}
+	vmID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil || vmID <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' does not contain a valid VM ID.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), int32(vmID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), parts[2])...)
+}
+
+// setLinkDown reads the current configuration of the network interface, sets or removes its link_down option
+// and writes it back. The digest of the configuration which was read is passed along so that the update fails
+// rather than overwriting a concurrent change to the VM.
+func (r *vmNICLinkResource) setLinkDown(ctx context.Context, plan vmNICLinkResourceModel,
+	diags *diag.Diagnostics) {
+
+	nodeName := plan.NodeName.ValueString()
+	vmID := int(plan.VMID.ValueInt32())
+	name := plan.Interface.ValueString()
+	rawConfig, err := r.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		diags.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+	value := configString(rawConfig, name)
+	if value.IsNull() {
+		diags.AddError(
+			"Network Interface Not Found",
+			fmt.Sprintf("The virtual machine with the ID '%d' has no network interface '%s'.", vmID, name),
+		)
+		return
+	}
+
+	params := map[string]any{
+		name: setNetLinkDown(value.ValueString(), plan.LinkDown.ValueBool()),
+	}
+	if digest := configString(rawConfig, "digest"); !digest.IsNull() {
+		params["digest"] = digest.ValueString()
+	}
+	tflog.Info(ctx, "setting network interface link state", map[string]any{
+		"vm_id":     vmID,
+		"interface": name,
+		"link_down": plan.LinkDown.ValueBool(),
+	})
+	err = r.providerData.client.Put(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/config",
+		url.PathEscape(nodeName), vmID), params, nil)
+	if err != nil {
+		diags.AddError(
+			"Proxmox VE API: Failed to Update VM Config",
+			fmt.Sprintf("Failed to set the link state of the network interface '%s' of the virtual machine with "+
+				"the ID '%d':\n\t%s", name, vmID, err.Error()),
+		)
+	}
+}
+
+// setNetLinkDown returns the given network interface configuration with the link_down option set or removed.
+// The remaining options are kept verbatim and in their original order.
+func setNetLinkDown(config string, down bool) string {
+	options := []string{}
+	for _, option := range strings.Split(config, ",") {
+		key, _, _ := strings.Cut(option, "=")
+		if strings.TrimSpace(option) == "" || strings.TrimSpace(key) == "link_down" {
+			continue
+		}
+		options = append(options, option)
+	}
+	if down {
+		options = append(options, "link_down=1")
+	}
+	return strings.Join(options, ",")
+}
@@ -0,0 +1,50 @@
+package provider
+
+import "testing"
+
+func TestSetNetLinkDown(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		down   bool
+		want   string
+	}{
+		{
+			name:   "add",
+			config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,firewall=1,tag=100",
+			down:   true,
+			want:   "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,firewall=1,tag=100,link_down=1",
+		},
+		{
+			name:   "replace",
+			config: "virtio=BC:24:11:AA:BB:CC,link_down=0,bridge=vmbr0,queues=4",
+			down:   true,
+			want:   "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,queues=4,link_down=1",
+		},
+		{
+			name:   "remove",
+			config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,link_down=1,rate=12.5,trunks=10;20",
+			down:   false,
+			want:   "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0,rate=12.5,trunks=10;20",
+		},
+		{
+			name:   "remove padded",
+			config: "virtio=BC:24:11:AA:BB:CC, link_down = 1 ,bridge=vmbr0",
+			down:   false,
+			want:   "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0",
+		},
+		{
+			name:   "already up",
+			config: "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0",
+			down:   false,
+			want:   "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := setNetLinkDown(test.config, test.down); got != test.want {
+				t.Errorf("setNetLinkDown(%q, %t) = %q, want %q", test.config, test.down, got, test.want)
+			}
+		})
+	}
+}
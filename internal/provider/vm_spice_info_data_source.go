@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmSPICEInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmSPICEInfoDataSource{}
+)
+
+func NewVMSPICEInfoDataSource() datasource.DataSource {
+	return &vmSPICEInfoDataSource{}
+}
+
+type vmSPICEInfoDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmSPICEInfoDataSourceModel struct {
+	Data   *vmSPICEInfoDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmSPICEInfoDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmSPICEInfoDataSourceFilterModel struct {
+	IncludeTicket types.Bool   `tfsdk:"include_ticket"`
+	NodeName      types.String `tfsdk:"node_name"`
+	VMID          types.Int32  `tfsdk:"vm_id"`
+}
+
+type vmSPICEInfoDataSourceDataModel struct {
+	CA          types.String `tfsdk:"ca"`
+	Host        types.String `tfsdk:"host"`
+	HostSubject types.String `tfsdk:"host_subject"`
+	Proxy       types.String `tfsdk:"proxy"`
+	Ticket      types.String `tfsdk:"ticket"`
+	Title       types.String `tfsdk:"title"`
+	TLSPort     types.Int64  `tfsdk:"tls_port"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (d *vmSPICEInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmSPICEInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_spice_info"
+}
+
+func (d *vmSPICEInfoDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the SPICE connection information of a running VM, eg: to generate a remote-viewer " +
+			"connection file.",
+		MarkdownDescription: "Retrieves the SPICE connection information of a running VM, eg: to generate a " +
+			"`remote-viewer` connection file.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"ca": schema.StringAttribute{
+						Description:         "CA certificate used to verify the SPICE proxy",
+						MarkdownDescription: "CA certificate used to verify the SPICE proxy",
+						Computed:            true,
+					},
+					"host": schema.StringAttribute{
+						Computed: true,
+					},
+					"host_subject": schema.StringAttribute{
+						Computed: true,
+					},
+					"proxy": schema.StringAttribute{
+						Description:         "SPICE proxy URL (eg: http://pve1:3128)",
+						MarkdownDescription: "SPICE proxy URL (eg: `http://pve1:3128`)",
+						Computed:            true,
+					},
+					"ticket": schema.StringAttribute{
+						Description: "Short-lived SPICE ticket (password); null unless include_ticket is set in " +
+							"the filter",
+						MarkdownDescription: "Short-lived SPICE ticket (password); null unless `include_ticket` is " +
+							"set in the filter",
+						Computed:  true,
+						Sensitive: true,
+					},
+					"title": schema.StringAttribute{
+						Computed: true,
+					},
+					"tls_port": schema.Int64Attribute{
+						Computed: true,
+					},
+					"type": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"include_ticket": schema.BoolAttribute{
+						Description: "Return the SPICE ticket; it is stored in the Terraform state so only enable " +
+							"this when the state is adequately protected",
+						MarkdownDescription: "Return the SPICE ticket; it is stored in the Terraform state so only " +
+							"enable this when the state is adequately protected",
+						Optional: true,
+					},
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmSPICEInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmSPICEInfoDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID and node are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the VM SPICE information.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM SPICE "+
+				"information or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the VM SPICE information.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+
+	// make sure the VM is running with a SPICE display
+	vm := d.providerData.virtualMachine(ctx, nodeName, vmID, &resp.Diagnostics)
+	if vm == nil {
+		return
+	}
+	rawConfig, err := d.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+	display := propertyString(parsePropertyString(configString(rawConfig, "vga").ValueString(), "type"), "type")
+	if !strings.HasPrefix(display.ValueString(), "qxl") {
+		resp.Diagnostics.AddError(
+			"SPICE Not Configured",
+			fmt.Sprintf("The virtual machine with the ID '%d' does not use a SPICE display; its 'vga' option must "+
+				"be set to one of the 'qxl' display types.", vmID),
+		)
+		return
+	}
+	if vm.Status != "running" {
+		resp.Diagnostics.AddError(
+			"VM Not Running",
+			fmt.Sprintf("The virtual machine with the ID '%d' must be running to retrieve its SPICE information "+
+				"but its status is '%s'.", vmID, vm.Status),
+		)
+		return
+	}
+
+	// query for the SPICE connection information; this always creates a new ticket
+	var info map[string]any
+	err = d.providerData.client.Post(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/spiceproxy",
+		url.PathEscape(nodeName), vmID), nil, &info)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve SPICE information", map[string]any{
+			"node_name": nodeName,
+			"vm_id":     vmID,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM SPICE Information",
+			fmt.Sprintf("Failed to retrieve the SPICE information of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := vmSPICEInfoDataSourceModel{
+		Data: &vmSPICEInfoDataSourceDataModel{
+			CA:          configString(info, "ca"),
+			Host:        configString(info, "host"),
+			HostSubject: configString(info, "host-subject"),
+			Proxy:       configString(info, "proxy"),
+			Ticket:      types.StringNull(),
+			Title:       configString(info, "title"),
+			TLSPort:     configInt64(info, "tls-port"),
+			Type:        configString(info, "type"),
+		},
+		Filter: config.Filter,
+	}
+	if config.Filter.IncludeTicket.ValueBool() {
+		state.Data.Ticket = configString(info, "password")
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
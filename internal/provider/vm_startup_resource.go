@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vmStartupResource{}
+	_ resource.ResourceWithConfigure      = &vmStartupResource{}
+	_ resource.ResourceWithImportState    = &vmStartupResource{}
+	_ resource.ResourceWithValidateConfig = &vmStartupResource{}
+)
+
+func NewVMStartupResource() resource.Resource {
+	return &vmStartupResource{}
+}
+
+type vmStartupResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmStartupResourceModel struct {
+	DownDelay types.Int64  `tfsdk:"down_delay"`
+	NodeName  types.String `tfsdk:"node_name"`
+	OnBoot    types.Bool   `tfsdk:"onboot"`
+	Order     types.Int64  `tfsdk:"order"`
+	UpDelay   types.Int64  `tfsdk:"up_delay"`
+	VMID      types.Int32  `tfsdk:"vm_id"`
+}
+
+func (r *vmStartupResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *vmStartupResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_startup"
+}
+
+func (r *vmStartupResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages whether a VM is started when its node boots and its position in the startup order " +
+			"without managing the rest of the VM. Omitted values are removed from the VM's configuration so the " +
+			"defaults apply, and destroying the resource removes all of them. Existing settings are imported " +
+			"using an ID in the format node_name/vm_id (eg: pve1/100).",
+		MarkdownDescription: "Manages whether a VM is started when its node boots and its position in the startup " +
+			"order without managing the rest of the VM. Omitted values are removed from the VM's configuration " +
+			"so the defaults apply, and destroying the resource removes all of them. Existing settings are " +
+			"imported using an ID in the format `node_name/vm_id` (eg: `pve1/100`).",
+		Attributes: map[string]schema.Attribute{
+			"down_delay": schema.Int64Attribute{
+				Description:         "Timeout in seconds to wait for the VM to shut down",
+				MarkdownDescription: "Timeout in seconds to wait for the VM to shut down",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"onboot": schema.BoolAttribute{
+				Description:         "Whether or not the VM is started when the node boots",
+				MarkdownDescription: "Whether or not the VM is started when the node boots",
+				Optional:            true,
+			},
+			"order": schema.Int64Attribute{
+				Description: "Position in the startup order; VMs are started in ascending and shut down in " +
+					"descending order",
+				MarkdownDescription: "Position in the startup order; VMs are started in ascending and shut down " +
+					"in descending order",
+				Optional: true,
+			},
+			"up_delay": schema.Int64Attribute{
+				Description:         "Delay in seconds before the next VM is started",
+				MarkdownDescription: "Delay in seconds before the next VM is started",
+				Optional:            true,
+			},
+			"vm_id": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vmStartupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config vmStartupResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.Int64{
+		"down_delay": config.DownDelay,
+		"order":      config.Order,
+		"up_delay":   config.UpDelay,
+	} {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Startup Value",
+				fmt.Sprintf("The %s must not be negative: %d", name, value.ValueInt64()),
+			)
+		}
+	}
+}
+
+func (r *vmStartupResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmStartupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the startup options
+	vmID := int(plan.VMID.ValueInt32())
+	if err := r.setStartup(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update VM Config",
+			fmt.Sprintf("Failed to set the startup options of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmStartupResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmStartupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the configuration
+	nodeName := state.NodeName.ValueString()
+	vmID := int(state.VMID.ValueInt32())
+	rawConfig, err := r.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if isNotFoundError(err) {
+		tflog.Warn(ctx, "VM no longer exists", map[string]any{"node_name": nodeName, "vm_id": vmID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+	state.OnBoot = configBool(rawConfig, "onboot", state.OnBoot)
+	state.DownDelay = types.Int64Null()
+	state.Order = types.Int64Null()
+	state.UpDelay = types.Int64Null()
+	if startup := parseStartup(configString(rawConfig, "startup").ValueString()); startup != nil {
+		state.DownDelay = startup.Down
+		state.Order = startup.Order
+		state.UpDelay = startup.Up
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmStartupResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmStartupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the startup options
+	vmID := int(plan.VMID.ValueInt32())
+	if err := r.setStartup(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update VM Config",
+			fmt.Sprintf("Failed to set the startup options of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmStartupResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmStartupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remove the startup options so the defaults apply
+	state.DownDelay = types.Int64Null()
+	state.OnBoot = types.BoolNull()
+	state.Order = types.Int64Null()
+	state.UpDelay = types.Int64Null()
+	vmID := int(state.VMID.ValueInt32())
+	if err := r.setStartup(ctx, state); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update VM Config",
+			fmt.Sprintf("Failed to remove the startup options of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *vmStartupResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	// the startup options are filled in by the subsequent read
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' is not in the format node_name/vm_id.", req.ID),
+		)
+		return
+	}
+	vmID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil || vmID <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' does not contain a valid VM ID.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), int32(vmID))...)
+}
+
+// setStartup writes the onboot and startup options of the VM, removing those which are not set.
+func (r *vmStartupResource) setStartup(ctx context.Context, plan vmStartupResourceModel) error {
+	nodeName := plan.NodeName.ValueString()
+	vmID := int(plan.VMID.ValueInt32())
+	params := map[string]any{}
+	deletes := []string{}
+	if plan.OnBoot.IsNull() {
+		deletes = append(deletes, "onboot")
+	} else {
+		params["onboot"] = boolToInt(plan.OnBoot.ValueBool())
+	}
+	if startup := formatStartup(plan.Order, plan.UpDelay, plan.DownDelay); startup == "" {
+		deletes = append(deletes, "startup")
+	} else {
+		params["startup"] = startup
+	}
+	if len(deletes) > 0 {
+		params["delete"] = strings.Join(deletes, ",")
+	}
+
+	tflog.Info(ctx, "setting VM startup options", map[string]any{"vm_id": vmID, "params": params})
+	return r.providerData.client.Put(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/config",
+		url.PathEscape(nodeName), vmID), params, nil)
+}
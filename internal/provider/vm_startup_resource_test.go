@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVMStartupUpdate(t *testing.T) {
+	tests := []struct {
+		name string
+		plan vmStartupResourceModel
+		want map[string]any
+	}{
+		{
+			name: "order with delays",
+			plan: vmStartupResourceModel{
+				DownDelay: types.Int64Value(60),
+				OnBoot:    types.BoolValue(true),
+				Order:     types.Int64Value(2),
+				UpDelay:   types.Int64Value(30),
+			},
+			want: map[string]any{"onboot": float64(1), "startup": "order=2,up=30,down=60"},
+		},
+		{
+			name: "delays cleared",
+			plan: vmStartupResourceModel{
+				DownDelay: types.Int64Null(),
+				OnBoot:    types.BoolValue(false),
+				Order:     types.Int64Value(2),
+				UpDelay:   types.Int64Null(),
+			},
+			want: map[string]any{"onboot": float64(0), "startup": "order=2"},
+		},
+		{
+			name: "everything cleared",
+			plan: vmStartupResourceModel{
+				DownDelay: types.Int64Null(),
+				OnBoot:    types.BoolNull(),
+				Order:     types.Int64Null(),
+				UpDelay:   types.Int64Null(),
+			},
+			want: map[string]any{"delete": "onboot,startup"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got map[string]any
+			data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut || r.URL.Path != "/api2/json/nodes/pve1/qemu/100/config" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+					return
+				}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("failed to decode the request: %v", err)
+				}
+				writeTestData(t, w, nil)
+			})
+			state := vmStartupResourceModel{
+				DownDelay: types.Int64Value(120),
+				NodeName:  types.StringValue("pve1"),
+				OnBoot:    types.BoolValue(true),
+				Order:     types.Int64Value(1),
+				UpDelay:   types.Int64Value(10),
+				VMID:      types.Int32Value(100),
+			}
+			test.plan.NodeName = state.NodeName
+			test.plan.VMID = state.VMID
+			diags := updateTestResource(t, &vmStartupResource{providerData: data}, state, test.plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("config update = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmStatusDataSource{}
+)
+
+func NewVMStatusDataSource() datasource.DataSource {
+	return &vmStatusDataSource{}
+}
+
+type vmStatusDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmStatusDataSourceModel struct {
+	Data   *vmStatusDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmStatusDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmStatusDataSourceFilterModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type vmStatusDataSourceDataModel struct {
+	guestStatusModel
+	BalloonActual     types.Int64  `tfsdk:"balloon_actual"`
+	CPUAffinityActual types.String `tfsdk:"cpu_affinity_actual"`
+	MemUsed           types.Int64  `tfsdk:"mem_used"`
+}
+
+func (d *vmStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_status"
+}
+
+func (d *vmStatusDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	dataAttributes := map[string]schema.Attribute{
+		"balloon_actual": schema.Int64Attribute{
+			Description: "Memory currently assigned to the guest by the balloon driver in bytes; null when the " +
+				"VM is not running or ballooning is unavailable",
+			MarkdownDescription: "Memory currently assigned to the guest by the balloon driver in bytes; null " +
+				"when the VM is not running or ballooning is unavailable",
+			Computed: true,
+		},
+		"cpu_affinity_actual": schema.StringAttribute{
+			Description: "Host CPUs the running VM may use (eg: 0-3,8); the API does not expose the live cgroup " +
+				"so this is the affinity the VM was started with, or every CPU of the node if it is not pinned. " +
+				"Null when the VM is not running",
+			MarkdownDescription: "Host CPUs the running VM may use (eg: `0-3,8`); the API does not expose the " +
+				"live cgroup so this is the `affinity` the VM was started with, or every CPU of the node if it is " +
+				"not pinned. Null when the VM is not running",
+			Computed: true,
+		},
+		"mem_used": schema.Int64Attribute{
+			Description:         "Memory currently used by the VM in bytes; null when the VM is not running",
+			MarkdownDescription: "Memory currently used by the VM in bytes; null when the VM is not running",
+			Computed:            true,
+		},
+	}
+	maps.Copy(dataAttributes, guestStatusSchemaAttributes())
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed:   true,
+				Attributes: dataAttributes,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmStatusDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID and node are specified
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the VM status.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM status "+
+				"or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the VM status.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+
+	// query for the status
+	status, err := d.providerData.currentGuestStatus(ctx, nodeName, guestTypeQEMU, vmID)
+	if err != nil {
+		tflog.Error(ctx, "failed to retrieve VM status", map[string]any{
+			"node_name": nodeName,
+			"vm_id":     vmID,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Status",
+			fmt.Sprintf("Failed to retrieve the status of the virtual machine with the ID '%d':\n\t%s", vmID,
+				err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := vmStatusDataSourceModel{
+		Data: &vmStatusDataSourceDataModel{
+			guestStatusModel:  status.model(),
+			BalloonActual:     types.Int64Null(),
+			CPUAffinityActual: types.StringNull(),
+			MemUsed:           types.Int64Null(),
+		},
+		Filter: config.Filter,
+	}
+	if status.Status == "running" {
+		state.Data.MemUsed = uint64Value(status.Mem)
+		if status.BalloonInfo != nil {
+			state.Data.BalloonActual = uint64Value(status.BalloonInfo.Actual)
+		}
+		affinity, err := d.effectiveAffinity(ctx, nodeName, vmID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve VM CPU Affinity",
+				fmt.Sprintf("Failed to retrieve the CPU affinity of the virtual machine with the ID '%d':\n\t%s",
+					vmID, err.Error()),
+			)
+			return
+		}
+		state.Data.CPUAffinityActual = types.StringValue(affinity)
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// effectiveAffinity returns the host CPUs the running VM is pinned to. The affinity is applied when the VM
+// starts, so the current configuration without pending changes is used. A VM without an affinity may use every
+// CPU of the node.
+func (d *vmStatusDataSource) effectiveAffinity(ctx context.Context, nodeName string, vmID int) (string, error) {
+	var current map[string]any
+	err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/config?current=1",
+		url.PathEscape(nodeName), vmID), &current)
+	if err != nil {
+		return "", err
+	}
+	if affinity := strings.TrimSpace(configString(current, "affinity").ValueString()); affinity != "" {
+		return affinity, nil
+	}
+
+	var status nodeStatus
+	if err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/status",
+		url.PathEscape(nodeName)), &status); err != nil {
+		return "", err
+	}
+	if status.CPUInfo.CPUs <= 1 {
+		return "0", nil
+	}
+	return fmt.Sprintf("0-%d", status.CPUInfo.CPUs-1), nil
+}
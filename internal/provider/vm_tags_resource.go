@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vmTagsResource{}
+	_ resource.ResourceWithConfigure      = &vmTagsResource{}
+	_ resource.ResourceWithImportState    = &vmTagsResource{}
+	_ resource.ResourceWithValidateConfig = &vmTagsResource{}
+)
+
+func NewVMTagsResource() resource.Resource {
+	return &vmTagsResource{}
+}
+
+type vmTagsResource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmTagsResourceModel struct {
+	Exclusive types.Bool     `tfsdk:"exclusive"`
+	NodeName  types.String   `tfsdk:"node_name"`
+	Tags      []types.String `tfsdk:"tags"`
+	VMID      types.Int32    `tfsdk:"vm_id"`
+}
+
+func (r *vmTagsResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *vmTagsResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_tags"
+}
+
+func (r *vmTagsResource) Schema(_ context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages tags of a VM without managing the rest of the VM. Only the tags in tags are owned " +
+			"by the resource: tags added by other tools are kept on update and destroy unless exclusive is " +
+			"enabled, in which case the VM's tags are reconciled to exactly the managed set. Existing tags are " +
+			"imported using an ID in the format node_name/vm_id (eg: pve1/100); the imported resource owns every " +
+			"tag the VM has at that time.",
+		MarkdownDescription: "Manages tags of a VM without managing the rest of the VM. Only the tags in `tags` " +
+			"are owned by the resource: tags added by other tools are kept on update and destroy unless " +
+			"`exclusive` is enabled, in which case the VM's tags are reconciled to exactly the managed set. " +
+			"Existing tags are imported using an ID in the format `node_name/vm_id` (eg: `pve1/100`); the imported " +
+			"resource owns every tag the VM has at that time.",
+		Attributes: map[string]schema.Attribute{
+			"exclusive": schema.BoolAttribute{
+				Description: "When true, tags which are not in tags are removed from the VM. Defaults to false, " +
+					"which leaves them intact",
+				MarkdownDescription: "When `true`, tags which are not in `tags` are removed from the VM. Defaults " +
+					"to `false`, which leaves them intact",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"node_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description:         "Tags managed by the resource, in lower case (eg: prod)",
+				MarkdownDescription: "Tags managed by the resource, in lower case (eg: `prod`)",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"vm_id": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vmTagsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var config vmTagsResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// tags are stored normalized, so anything else would never match what is read back
+	for _, tag := range config.Tags {
+		if tag.IsNull() || tag.IsUnknown() {
+			continue
+		}
+		if normalized := normalizeTags(tag.ValueString()); len(normalized) != 1 || normalized[0] != tag.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tags"),
+				"Invalid Tag",
+				fmt.Sprintf("The tag '%s' is not valid; tags must be lower case and must not contain commas, "+
+					"semicolons or whitespace.", tag.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *vmTagsResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan
+	var plan vmTagsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// add the managed tags to the current ones
+	nodeName := plan.NodeName.ValueString()
+	vmID := int(plan.VMID.ValueInt32())
+	managed := stringValues(plan.Tags)
+	current, err := r.tags(ctx, nodeName, vmID)
+	if err == nil {
+		err = r.setTags(ctx, nodeName, vmID, reconcileTags(current, nil, managed, plan.Exclusive.ValueBool()))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update VM Tags",
+			fmt.Sprintf("Failed to set the tags of the virtual machine with the ID '%d':\n\t%s", vmID,
+				err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmTagsResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmTagsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// query for the current tags of the VM
+	nodeName := state.NodeName.ValueString()
+	vmID := int(state.VMID.ValueInt32())
+	current, err := r.tags(ctx, nodeName, vmID)
+	if isNotFoundError(err) {
+		tflog.Warn(ctx, "VM no longer exists", map[string]any{"node_name": nodeName, "vm_id": vmID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve VM Config",
+			fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+				vmID, err.Error()),
+		)
+		return
+	}
+
+	// only the managed tags are tracked unless every tag is owned by the resource; the state has no tags yet
+	// right after an import, in which case the resource adopts all of them
+	imported := state.Tags == nil
+	managed := stringValues(state.Tags)
+	state.Tags = []types.String{}
+	for _, tag := range current {
+		if imported || state.Exclusive.ValueBool() || slices.Contains(managed, tag) {
+			state.Tags = append(state.Tags, types.StringValue(tag))
+		}
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmTagsResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read plan and state
+	var plan, state vmTagsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// apply the tags which were added and removed to the current tags
+	nodeName := plan.NodeName.ValueString()
+	vmID := int(plan.VMID.ValueInt32())
+	current, err := r.tags(ctx, nodeName, vmID)
+	if err == nil {
+		err = r.setTags(ctx, nodeName, vmID, reconcileTags(current, stringValues(state.Tags),
+			stringValues(plan.Tags), plan.Exclusive.ValueBool()))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update VM Tags",
+			fmt.Sprintf("Failed to update the tags of the virtual machine with the ID '%d':\n\t%s", vmID,
+				err.Error()),
+		)
+		return
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vmTagsResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+
+	ctx = r.providerData.AddLogContext(ctx)
+
+	// read state
+	var state vmTagsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remove the managed tags, keeping any which were added since
+	nodeName := state.NodeName.ValueString()
+	vmID := int(state.VMID.ValueInt32())
+	current, err := r.tags(ctx, nodeName, vmID)
+	if isNotFoundError(err) {
+		return
+	}
+	if err == nil {
+		err = r.setTags(ctx, nodeName, vmID, reconcileTags(current, stringValues(state.Tags), nil, false))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Update VM Tags",
+			fmt.Sprintf("Failed to remove the tags of the virtual machine with the ID '%d':\n\t%s", vmID,
+				err.Error()),
+		)
+		return
+	}
+}
+
+func (r *vmTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+
+	// an imported resource owns every tag of the VM; the tags are filled in by the subsequent read
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' is not in the format node_name/vm_id.", req.ID),
+		)
+		return
+	}
+	vmID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil || vmID <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID '%s' does not contain a valid VM ID.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), int32(vmID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exclusive"), false)...)
+}
+
+// tags returns the normalized tags of the given VM.
+func (r *vmTagsResource) tags(ctx context.Context, nodeName string, vmID int) ([]string, error) {
+	rawConfig, err := r.providerData.rawVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeTags(configString(rawConfig, "tags").ValueString()), nil
+}
+
+// setTags replaces the tags of the given VM, removing the option entirely when there are none.
+func (r *vmTagsResource) setTags(ctx context.Context, nodeName string, vmID int, tags []string) error {
+	tflog.Info(ctx, "setting VM tags", map[string]any{"node_name": nodeName, "vm_id": vmID, "tags": tags})
+	params := map[string]any{"tags": strings.Join(tags, ";")}
+	if len(tags) == 0 {
+		params = map[string]any{"delete": "tags"}
+	}
+	return r.providerData.client.Put(ctx, fmt.Sprintf("/nodes/%s/qemu/%d/config",
+		url.PathEscape(nodeName), vmID), params, nil)
+}
+
+// reconcileTags returns the tags a VM should have given its current tags, the tags previously managed and the
+// tags to manage from now on. Previously managed tags which are no longer wanted are removed and the wanted tags
+// are added; other tags are kept unless exclusive is set.
+func reconcileTags(current, previous, wanted []string, exclusive bool) []string {
+	tags := []string{}
+	if !exclusive {
+		for _, tag := range current {
+			if !slices.Contains(previous, tag) || slices.Contains(wanted, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	tags = append(tags, wanted...)
+	slices.Sort(tags)
+	return slices.Compact(tags)
+}
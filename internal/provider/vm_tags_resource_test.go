@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReconcileTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   []string
+		previous  []string
+		wanted    []string
+		exclusive bool
+		want      []string
+	}{
+		{
+			name:    "create keeps foreign tags",
+			current: []string{"backup", "team-a"},
+			wanted:  []string{"prod", "web"},
+			want:    []string{"backup", "prod", "team-a", "web"},
+		},
+		{
+			name:     "update removes only owned tags",
+			current:  []string{"backup", "prod", "web"},
+			previous: []string{"prod", "web"},
+			wanted:   []string{"prod", "db"},
+			want:     []string{"backup", "db", "prod"},
+		},
+		{
+			name:     "foreign tag also wanted",
+			current:  []string{"backup", "prod"},
+			previous: []string{"prod"},
+			wanted:   []string{"backup"},
+			want:     []string{"backup"},
+		},
+		{
+			name:     "delete removes only owned tags",
+			current:  []string{"backup", "prod", "team-a"},
+			previous: []string{"prod"},
+			wanted:   []string{},
+			want:     []string{"backup", "team-a"},
+		},
+		{
+			name:      "exclusive drops foreign tags",
+			current:   []string{"backup", "prod", "team-a"},
+			previous:  []string{"prod"},
+			wanted:    []string{"prod", "web"},
+			exclusive: true,
+			want:      []string{"prod", "web"},
+		},
+		{
+			name:      "exclusive with nothing wanted",
+			current:   []string{"backup", "prod"},
+			exclusive: true,
+			wanted:    []string{},
+			want:      []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := reconcileTags(test.current, test.previous, test.wanted, test.exclusive)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("reconcileTags(%v, %v, %v, %t) = %v, want %v", test.current, test.previous, test.wanted,
+					test.exclusive, got, test.want)
+			}
+		})
+	}
+}
+
+// newTestVMTagsResource returns a VM tags resource whose VM 100 on the node pve1 has the given tags.
+func newTestVMTagsResource(t *testing.T, tags string) *vmTagsResource {
+	t.Helper()
+	data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api2/json/nodes/pve1/qemu/100/config" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		writeTestData(t, w, map[string]any{"tags": tags})
+	})
+	return &vmTagsResource{providerData: data}
+}
+
+// vmTagsState returns the tags and exclusive mode stored in the given state.
+func vmTagsState(t *testing.T, state tfsdk.State) ([]string, types.Bool) {
+	t.Helper()
+	var model vmTagsResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("failed to read the state: %v", diags)
+	}
+	return stringValues(model.Tags), model.Exclusive
+}
+
+func TestVMTagsImport(t *testing.T) {
+	state, diags := importTestResource(t, newTestVMTagsResource(t, "Prod;web,team-a"), "pve1/100")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	tags, exclusive := vmTagsState(t, state)
+	if want := []string{"prod", "team-a", "web"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("tags = %v, want every tag of the VM %v", tags, want)
+	}
+	if exclusive != types.BoolValue(false) {
+		t.Errorf("exclusive = %v, want the default false", exclusive)
+	}
+}
+
+func TestVMTagsReadForeignTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		exclusive bool
+		want      []string
+	}{
+		{name: "owned tags only", want: []string{"prod"}},
+		{name: "exclusive", exclusive: true, want: []string{"backup", "prod", "team-a"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state, diags := readTestResource(t, newTestVMTagsResource(t, "backup;prod;team-a"), vmTagsResourceModel{
+				Exclusive: types.BoolValue(test.exclusive),
+				NodeName:  types.StringValue("pve1"),
+				Tags:      []types.String{types.StringValue("prod"), types.StringValue("web")},
+				VMID:      types.Int32Value(100),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if tags, _ := vmTagsState(t, state); !reflect.DeepEqual(tags, test.want) {
+				t.Errorf("tags = %v, want %v", tags, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmTaskLogDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmTaskLogDataSource{}
+)
+
+func NewVMTaskLogDataSource() datasource.DataSource {
+	return &vmTaskLogDataSource{}
+}
+
+type vmTaskLogDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmTaskLogDataSourceModel struct {
+	Data   *vmTaskLogDataSourceDataModel   `tfsdk:"data"`
+	Filter *vmTaskLogDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmTaskLogDataSourceFilterModel struct {
+	Limit    types.Int64  `tfsdk:"limit"`
+	NodeName types.String `tfsdk:"node_name"`
+	UPID     types.String `tfsdk:"upid"`
+	VMID     types.Int32  `tfsdk:"vm_id"`
+}
+
+type vmTaskLogDataSourceDataModel struct {
+	Lines []logLineModel `tfsdk:"lines"`
+	UPID  types.String   `tfsdk:"upid"`
+}
+
+// nodeTask is a single entry from a node's task list.
+type nodeTask struct {
+	StartTime int64  `json:"starttime"`
+	UPID      string `json:"upid"`
+}
+
+func (d *vmTaskLogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmTaskLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vm_tasklog"
+}
+
+func (d *vmTaskLogDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the log of a VM's most recent task or of a specific task.",
+		MarkdownDescription: "Retrieves the log of a VM's most recent task or of a specific task.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"lines": logLinesSchema(),
+					"upid": schema.StringAttribute{
+						Description:         "ID of the task the log belongs to",
+						MarkdownDescription: "ID of the task the log belongs to",
+						Computed:            true,
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"limit": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of lines to return (default: %d, maximum: %d)",
+							defaultLogLineLimit, maxLogLineLimit),
+						MarkdownDescription: fmt.Sprintf(
+							"Maximum number of lines to return (default: `%d`, maximum: `%d`)",
+							defaultLogLineLimit, maxLogLineLimit),
+						Optional: true,
+					},
+					"node_name": schema.StringAttribute{
+						Description: "Name of the node hosting the VM; defaults to the provider's default_node " +
+							"when omitted",
+						MarkdownDescription: "Name of the node hosting the VM; defaults to the provider's " +
+							"`default_node` when omitted",
+						Optional: true,
+					},
+					"upid": schema.StringAttribute{
+						Description:         "ID of the task; defaults to the VM's most recent task",
+						MarkdownDescription: "ID of the task; defaults to the VM's most recent task",
+						Optional:            true,
+					},
+					"vm_id": schema.Int32Attribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmTaskLogDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmTaskLogDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// make sure a VM ID and node are specified and the limit is valid
+	if config.Filter == nil {
+		resp.Diagnostics.AddError(
+			"Filter Is Required", "You must specify a filter to retrieve the VM task log.",
+		)
+		return
+	}
+	nodeName := d.providerData.NodeName(config.Filter.NodeName)
+	if nodeName == "" {
+		resp.Diagnostics.AddError(
+			"Filter Node Name Is Required", "You must specify a PVE cluster node name to retrieve the VM task "+
+				"log or configure a default node for the provider.",
+		)
+		return
+	}
+	if config.Filter.VMID.IsNull() || config.Filter.VMID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Filter VM ID Is Required", "You must specify a VM ID to retrieve the VM task log.",
+		)
+		return
+	}
+	vmID := int(config.Filter.VMID.ValueInt32())
+	limit, err := logLineLimit(config.Filter.Limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Filter Limit", fmt.Sprintf("The filter limit is invalid: %s", err.Error()),
+		)
+		return
+	}
+
+	// find the VM's most recent task unless a specific one was requested
+	upid := config.Filter.UPID.ValueString()
+	if upid == "" {
+		var tasks []nodeTask
+		err := d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/tasks?vmid=%d&source=all",
+			url.PathEscape(nodeName), vmID), &tasks)
+		if err != nil {
+			tflog.Error(ctx, "failed to retrieve VM tasks", map[string]any{
+				"node_name": nodeName,
+				"vm_id":     vmID,
+				"error":     err.Error(),
+			})
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve VM Tasks",
+				fmt.Sprintf("Failed to retrieve the tasks of the virtual machine with the ID '%d':\n\t%s", vmID,
+					err.Error()),
+			)
+			return
+		}
+		var latest *nodeTask
+		for i := range tasks {
+			if latest == nil || tasks[i].StartTime > latest.StartTime {
+				latest = &tasks[i]
+			}
+		}
+		if latest == nil {
+			resp.Diagnostics.AddError(
+				"No VM Tasks Found",
+				fmt.Sprintf("No tasks were found for the virtual machine with the ID '%d' on the cluster node "+
+					"'%s'.", vmID, nodeName),
+			)
+			return
+		}
+		upid = latest.UPID
+	}
+
+	// query for the task log
+	var lines []logLine
+	err = d.providerData.client.Get(ctx, fmt.Sprintf("/nodes/%s/tasks/%s/log?start=0&limit=%d",
+		url.PathEscape(nodeName), url.PathEscape(upid), limit), &lines)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Task Log",
+			fmt.Sprintf("Failed to retrieve the log of the task '%s':\n\t%s", upid, err.Error()),
+		)
+		return
+	}
+
+	// map the response to the model
+	state := vmTaskLogDataSourceModel{
+		Data: &vmTaskLogDataSourceDataModel{
+			Lines: logLineModels(lines),
+			UPID:  types.StringValue(upid),
+		},
+		Filter: config.Filter,
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	proxmox "github.com/luthermonson/go-proxmox"
+)
+
+const (
+	tagMatchAll = "all"
+	tagMatchAny = "any"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vmsDataSource{}
+	_ datasource.DataSourceWithConfigure = &vmsDataSource{}
+)
+
+func NewVMsDataSource() datasource.DataSource {
+	return &vmsDataSource{}
+}
+
+type vmsDataSource struct {
+	providerData *proxmoxveProviderData
+}
+
+type vmsDataSourceModel struct {
+	Data   []vmsDataSourceVMModel    `tfsdk:"data"`
+	Filter *vmsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type vmsDataSourceFilterModel struct {
+	Match    types.String   `tfsdk:"match"`
+	NodeName types.String   `tfsdk:"node_name"`
+	Tags     []types.String `tfsdk:"tags"`
+}
+
+type vmsDataSourceVMModel struct {
+	Name     types.String   `tfsdk:"name"`
+	Node     types.String   `tfsdk:"node"`
+	OnBoot   types.Bool     `tfsdk:"onboot"`
+	Startup  *startupModel  `tfsdk:"startup"`
+	Status   types.String   `tfsdk:"status"`
+	Tags     []types.String `tfsdk:"tags"`
+	Template types.Bool     `tfsdk:"template"`
+	VMID     types.Int32    `tfsdk:"vm_id"`
+}
+
+func (d *vmsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*proxmoxveProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type", fmt.Sprintf(
+				"Expected *proxmoxveProviderData, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *vmsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_vms"
+}
+
+func (d *vmsDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the QEMU VMs in the cluster, sorted by VM ID, together with their boot behavior and " +
+			"tags.",
+		MarkdownDescription: "Lists the QEMU VMs in the cluster, sorted by VM ID, together with their boot " +
+			"behavior and tags.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"node": schema.StringAttribute{
+							Computed: true,
+						},
+						"onboot": schema.BoolAttribute{
+							Description:         "Whether or not the VM is started when the node boots",
+							MarkdownDescription: "Whether or not the VM is started when the node boots",
+							Computed:            true,
+						},
+						"startup": startupDataSourceSchemaAttribute(),
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListAttribute{
+							Description:         "Normalized tags of the VM, lower-cased and sorted",
+							MarkdownDescription: "Normalized tags of the VM, lower-cased and sorted",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"template": schema.BoolAttribute{
+							Computed: true,
+						},
+						"vm_id": schema.Int32Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"match": schema.StringAttribute{
+						Description: fmt.Sprintf("Whether VMs must carry %s or %s of the tags; defaults to %s",
+							tagMatchAny, tagMatchAll, tagMatchAny),
+						MarkdownDescription: fmt.Sprintf("Whether VMs must carry `%s` or `%s` of the `tags`; "+
+							"defaults to `%s`", tagMatchAny, tagMatchAll, tagMatchAny),
+						Optional: true,
+					},
+					"node_name": schema.StringAttribute{
+						Description:         "Only list the VMs on this node; VMs on all nodes are listed when omitted",
+						MarkdownDescription: "Only list the VMs on this node; VMs on all nodes are listed when omitted",
+						Optional:            true,
+					},
+					"tags": schema.ListAttribute{
+						Description: "Only list the VMs carrying these tags, compared case-insensitively; VMs are " +
+							"listed regardless of their tags when omitted",
+						MarkdownDescription: "Only list the VMs carrying these tags, compared case-insensitively; " +
+							"VMs are listed regardless of their tags when omitted",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+
+	ctx = d.providerData.AddLogContext(ctx)
+	ctx, cancel := d.providerData.WithReadTimeout(ctx)
+	defer cancel()
+
+	// read configuration
+	var config vmsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nodeName := ""
+	match := tagMatchAny
+	filterTags := []string{}
+	if config.Filter != nil {
+		nodeName = config.Filter.NodeName.ValueString()
+		if value := config.Filter.Match.ValueString(); value != "" {
+			match = value
+		}
+		for _, tag := range config.Filter.Tags {
+			filterTags = append(filterTags, normalizeTags(tag.ValueString())...)
+		}
+	}
+	if match != tagMatchAny && match != tagMatchAll {
+		resp.Diagnostics.AddError(
+			"Invalid Filter Match",
+			fmt.Sprintf("The filter match '%s' is not supported; it must be either '%s' or '%s'.", match,
+				tagMatchAny, tagMatchAll),
+		)
+		return
+	}
+
+	// query for the VMs
+	resources, err := d.providerData.clusterVMResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Proxmox VE API: Failed to Retrieve Cluster Resources",
+			fmt.Sprintf("Failed to retrieve the cluster resources:\n\t%s", err.Error()),
+		)
+		return
+	}
+	slices.SortFunc(resources, func(a, b *proxmox.ClusterResource) int {
+		return cmp.Compare(a.VMID, b.VMID)
+	})
+
+	// map the response to the model; the boot behavior is only part of each VM's configuration
+	state := vmsDataSourceModel{
+		Data:   []vmsDataSourceVMModel{},
+		Filter: config.Filter,
+	}
+	for _, res := range resources {
+		tags := normalizeTags(res.Tags)
+		if res.Type != guestTypeQEMU || (nodeName != "" && res.Node != nodeName) ||
+			!matchTags(tags, filterTags, match) {
+			continue
+		}
+		vmID := int(res.VMID)
+		rawConfig, err := d.providerData.rawVMConfig(ctx, res.Node, vmID)
+		if err != nil {
+			tflog.Error(ctx, "failed to retrieve VM config", map[string]any{"vm_id": vmID, "error": err.Error()})
+			resp.Diagnostics.AddError(
+				"Proxmox VE API: Failed to Retrieve VM Config",
+				fmt.Sprintf("Failed to retrieve the configuration of the virtual machine with the ID '%d':\n\t%s",
+					vmID, err.Error()),
+			)
+			return
+		}
+		vm := vmsDataSourceVMModel{
+			Name:     types.StringValue(res.Name),
+			Node:     types.StringValue(res.Node),
+			OnBoot:   configBool(rawConfig, "onboot", types.BoolValue(false)),
+			Startup:  parseStartup(configString(rawConfig, "startup").ValueString()),
+			Status:   types.StringValue(res.Status),
+			Tags:     []types.String{},
+			Template: types.BoolValue(res.Template == 1),
+			VMID:     types.Int32Value(int32(vmID)),
+		}
+		for _, tag := range tags {
+			vm.Tags = append(vm.Tags, types.StringValue(tag))
+		}
+		state.Data = append(state.Data, vm)
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// matchTags returns whether the normalized tags of a VM contain any or all (depending on the match mode) of the
+// wanted tags. Every VM matches when no tags are wanted.
+func matchTags(tags, wanted []string, match string) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	contains := func(tag string) bool {
+		return slices.Contains(tags, tag)
+	}
+	if match == tagMatchAll {
+		return !slices.ContainsFunc(wanted, func(tag string) bool { return !contains(tag) })
+	}
+	return slices.ContainsFunc(wanted, contains)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMatchTags(t *testing.T) {
+	tags := []string{"db", "prod", "web"}
+	tests := []struct {
+		name   string
+		wanted []string
+		match  string
+		want   bool
+	}{
+		{name: "any of one", wanted: []string{"prod"}, match: tagMatchAny, want: true},
+		{name: "any of several", wanted: []string{"dev", "web"}, match: tagMatchAny, want: true},
+		{name: "any of none present", wanted: []string{"dev", "test"}, match: tagMatchAny, want: false},
+		{name: "all present", wanted: []string{"prod", "web"}, match: tagMatchAll, want: true},
+		{name: "all with one missing", wanted: []string{"prod", "dev"}, match: tagMatchAll, want: false},
+		{name: "nothing wanted", wanted: []string{}, match: tagMatchAll, want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := matchTags(tags, test.wanted, test.match); got != test.want {
+				t.Errorf("matchTags(%v, %v, %q) = %t, want %t", tags, test.wanted, test.match, got, test.want)
+			}
+		})
+	}
+}
+
+func TestVMsReadTagFilter(t *testing.T) {
+	tests := []struct {
+		match string
+		want  []int32
+	}{
+		{match: tagMatchAny, want: []int32{100, 101, 102}},
+		{match: tagMatchAll, want: []int32{100}},
+	}
+	for _, test := range tests {
+		t.Run(test.match, func(t *testing.T) {
+			data := newTestProviderData(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api2/json/cluster/resources":
+					writeTestData(t, w, []map[string]any{
+						{"id": "qemu/100", "type": "qemu", "vmid": 100, "node": "pve1", "tags": "Prod;web"},
+						{"id": "qemu/101", "type": "qemu", "vmid": 101, "node": "pve1", "tags": "prod"},
+						{"id": "qemu/102", "type": "qemu", "vmid": 102, "node": "pve2", "tags": "web,db"},
+						{"id": "qemu/103", "type": "qemu", "vmid": 103, "node": "pve2", "tags": "dev"},
+						{"id": "lxc/104", "type": "lxc", "vmid": 104, "node": "pve2", "tags": "prod;web"},
+					})
+				default:
+					writeTestData(t, w, map[string]any{})
+				}
+			})
+			config := vmsDataSourceModel{Filter: &vmsDataSourceFilterModel{
+				Match: types.StringValue(test.match),
+				Tags:  []types.String{types.StringValue("prod"), types.StringValue("WEB")},
+			}}
+			var state vmsDataSourceModel
+			diags := readTestDataSource(t, &vmsDataSource{providerData: data}, config, &state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			got := []int32{}
+			for _, vm := range state.Data {
+				got = append(got, vm.VMID.ValueInt32())
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("vm ids = %v, want %v", got, test.want)
+			}
+		})
+	}
+}